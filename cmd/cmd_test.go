@@ -1,19 +1,34 @@
 package cmd
 
 import (
+	"bufio"
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"log"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"runtime"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"efctl/internal/testutil"
+	"efctl/pkg/builder"
 	"efctl/pkg/config"
-
+	"efctl/pkg/container"
+	"efctl/pkg/dashboard"
+	"efctl/pkg/doctor"
+	"efctl/pkg/setup"
+	"efctl/pkg/status"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -88,6 +103,25 @@ func TestSafeScriptNameRe_Invalid(t *testing.T) {
 	}
 }
 
+func TestRunExecArgs_DefaultWorkdirUsesPnpm(t *testing.T) {
+	args := runExecArgs(defaultRunWorkdir, "deploy", nil)
+	assert.Equal(t, []string{"/bin/bash", "-c", `cd -- "$1" && shift && exec "$@"`, "--", "/workspace/builder-scaffold", "pnpm", "deploy"}, args)
+}
+
+func TestRunExecArgs_CustomWorkdirPassesArgs(t *testing.T) {
+	args := runExecArgs("./world-contracts", "ls", []string{"-la"})
+	assert.Equal(t, []string{"/workspace/world-contracts", "ls", "-la"}, args[4:])
+}
+
+func TestValidateRunEnv(t *testing.T) {
+	for _, pair := range []string{"LOG_LEVEL=debug", "CI=1", "EMPTY=", "URL=http://sui-dev:9000", "LIST=a,b"} {
+		assert.NoError(t, validateRunEnv(pair), pair)
+	}
+	for _, pair := range []string{"NOVALUE", "1BAD=x", "=x", "X=$(id)", "X=a b", "X=a;b", "X=`id`"} {
+		assert.Error(t, validateRunEnv(pair), pair)
+	}
+}
+
 // ── fetchExpectedChecksum ──────────────────────────────────────────
 
 func TestFetchExpectedChecksum_Found(t *testing.T) {
@@ -397,9 +431,35 @@ func TestEnvUpFlagDefaultsEnabled(t *testing.T) {
 	assert.Equal(t, "true", frontendFlag.DefValue)
 }
 
+func TestEnvUpNoSummaryFlag(t *testing.T) {
+	flag := envUpCmd.Flags().Lookup("no-summary")
+	require.NotNil(t, flag)
+	assert.Equal(t, "false", flag.DefValue)
+}
+
+func TestSkipUpPhase_ResumeAfterDeploy(t *testing.T) {
+	ws := t.TempDir()
+	require.NoError(t, setup.MarkPhaseComplete(ws, setup.PhaseDeploy))
+	state, err := setup.ReadUpState(ws)
+	require.NoError(t, err)
+
+	assert.True(t, skipUpPhase(state, setup.PhaseClone, "skip clone"))
+	assert.True(t, skipUpPhase(state, setup.PhaseStart, "skip start"))
+	assert.True(t, skipUpPhase(state, setup.PhaseDeploy, "skip deploy"), "a completed deploy must not run again on --resume")
+}
+
+func TestSkipUpPhase_NoState(t *testing.T) {
+	assert.False(t, skipUpPhase(nil, setup.PhaseDeploy, "skip deploy"))
+}
+
 // ── doctor command ────────────────────────────────────────────────
 
 func TestDoctorCommand(t *testing.T) {
+	// The sandbox may lack a container engine; record the exit instead.
+	oldExit := doctorExit
+	doctorExit = func(int) {}
+	defer func() { doctorExit = oldExit }()
+
 	// Capture stdout via os.Pipe (doctor uses fmt.Printf → stdout).
 	oldStdout := os.Stdout
 	r, w, err := os.Pipe()
@@ -418,11 +478,43 @@ func TestDoctorCommand(t *testing.T) {
 
 	require.NoError(t, execErr)
 
-	for _, want := range []string{"efctl:", "os:", "wsl:", "container runtime:", "node:", "git:", "env:", "port", "config file:", "config with-frontend:"} {
+	for _, want := range []string{"efctl:", "os:", "wsl:", "container runtime:", "node:", "git:", "env:", "port", "config file:", "config with-frontend:", "check container-runtime:"} {
 		assert.Contains(t, output, want, "expected doctor output to contain %q", want)
 	}
 }
 
+func TestDoctorCommand_JSON(t *testing.T) {
+	exitCode := 0
+	oldExit := doctorExit
+	doctorExit = func(code int) { exitCode = code }
+	defer func() {
+		doctorExit = oldExit
+		doctorJSON = false
+	}()
+
+	oldStdout := os.Stdout
+	r, w, err := os.Pipe()
+	require.NoError(t, err)
+	os.Stdout = w
+
+	rootCmd.SetArgs([]string{"doctor", "--json", "--workspace", t.TempDir()})
+	execErr := rootCmd.Execute()
+
+	w.Close()
+	os.Stdout = oldStdout
+	require.NoError(t, execErr)
+
+	var checks []doctor.Check
+	require.NoError(t, json.NewDecoder(r).Decode(&checks))
+	require.NotEmpty(t, checks)
+	assert.Equal(t, "container-runtime", checks[0].Name)
+	if doctor.Passed(checks) {
+		assert.Equal(t, 0, exitCode)
+	} else {
+		assert.Equal(t, 1, exitCode)
+	}
+}
+
 func TestDoctorCommand_WorkspaceFlag(t *testing.T) {
 	flag := doctorCmd.Flags().Lookup("workspace")
 	require.NotNil(t, flag)
@@ -438,6 +530,31 @@ func TestCommandTree_DoctorExists(t *testing.T) {
 	assert.True(t, names["doctor"], "doctor command should exist")
 }
 
+// ── env info command ──────────────────────────────────────────────
+
+func TestEnvInfoCommand(t *testing.T) {
+	oldStdout := os.Stdout
+	r, w, err := os.Pipe()
+	require.NoError(t, err)
+	os.Stdout = w
+
+	rootCmd.SetArgs([]string{"env", "info", "--workspace", t.TempDir()})
+	execErr := rootCmd.Execute()
+
+	w.Close()
+	os.Stdout = oldStdout
+
+	var buf bytes.Buffer
+	_, _ = buf.ReadFrom(r)
+	output := buf.String()
+
+	require.NoError(t, execErr)
+
+	for _, want := range []string{"efctl", "workspace", "container engine", "sui", "builder-scaffold", "world-contracts", "world-contracts-ref", "config file"} {
+		assert.Contains(t, output, want, "expected env info output to contain %q", want)
+	}
+}
+
 // ── resolveDisplayHost ─────────────────────────────────────────────
 
 func TestResolveDisplayHost_Localhost(t *testing.T) {
@@ -498,6 +615,303 @@ func TestInitialModel_HostDefault(t *testing.T) {
 	assert.Equal(t, "127.0.0.1", m.host)
 }
 
+func TestInitialModel_DefaultFetchLimits(t *testing.T) {
+	m := initialModel("docker", t.TempDir())
+	assert.Equal(t, defaultDashFetchLimit, m.txLimit)
+	assert.Equal(t, defaultDashFetchLimit, m.eventsLimit)
+}
+
+func TestInitialModel_DefaultRefresh(t *testing.T) {
+	m := initialModel("docker", t.TempDir())
+	assert.Equal(t, defaultDashRefresh, m.refresh)
+	assert.False(t, m.paused)
+}
+
+func TestHandleMainKeyMsg_SpaceTogglesPause(t *testing.T) {
+	m := model{refresh: defaultDashRefresh}
+	space := tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}}
+
+	updated, _ := m.handleMainKeyMsg(space)
+	assert.True(t, updated.(model).paused)
+	assert.Contains(t, updated.(model).renderHeader(), "PAUSED")
+
+	updated, _ = updated.(model).handleMainKeyMsg(space)
+	assert.False(t, updated.(model).paused)
+}
+
+func TestHandleMainKeyMsg_TFocusesTxList(t *testing.T) {
+	m := initialModel("docker", t.TempDir())
+	updated, _ := m.handleMainKeyMsg(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("t")})
+	assert.False(t, updated.(model).txFocus, "tx focus needs at least one transaction")
+
+	m.recentTxs = []recentTx{{Digest: "A"}, {Digest: "B"}}
+	updated, _ = m.handleMainKeyMsg(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("t")})
+	assert.True(t, updated.(model).txFocus)
+}
+
+func TestHandleTxKeyMsg_NavigationAndClose(t *testing.T) {
+	m := initialModel("docker", t.TempDir())
+	m.recentTxs = []recentTx{{Digest: "A"}, {Digest: "B"}}
+	m.txFocus = true
+
+	next, _ := m.handleKeyMsg(tea.KeyMsg{Type: tea.KeyDown})
+	m = next.(model)
+	assert.Equal(t, 1, m.txCursor)
+	assert.Equal(t, 0, m.logScroll, "arrow keys must not scroll logs in tx focus")
+
+	next, _ = m.handleKeyMsg(tea.KeyMsg{Type: tea.KeyDown})
+	assert.Equal(t, 1, next.(model).txCursor, "cursor stays on the last row")
+
+	next, cmd := m.handleKeyMsg(tea.KeyMsg{Type: tea.KeyEnter})
+	m = next.(model)
+	assert.True(t, m.txLoading)
+	assert.NotNil(t, cmd)
+
+	next, _ = m.Update(txDetailMsg{detail: txDetail{Digest: "B", Status: "success"}})
+	m = next.(model)
+	require.NotNil(t, m.txDetail)
+	assert.False(t, m.txLoading)
+
+	next, _ = m.handleKeyMsg(tea.KeyMsg{Type: tea.KeyEsc})
+	m = next.(model)
+	assert.Nil(t, m.txDetail)
+	assert.True(t, m.txFocus, "first esc only closes the detail panel")
+
+	next, _ = m.handleKeyMsg(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.False(t, next.(model).txFocus)
+}
+
+func TestFetchTxDetail(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.Contains(t, string(body), "sui_getTransactionBlock")
+		assert.Contains(t, string(body), "DIGEST123")
+		_, _ = w.Write([]byte(`{"result":{"digest":"DIGEST123","checkpoint":"42",
+			"transaction":{"data":{"sender":"0xabc"}},
+			"effects":{"status":{"status":"failure","error":"MoveAbort"},
+				"gasUsed":{"computationCost":"1000","storageCost":"2000","storageRebate":"500"},
+				"created":[{}],"mutated":[{},{}]},
+			"events":[{"type":"0x1::gate::JumpEvent<0x2::sui::SUI>"}]}}`))
+	}))
+	defer srv.Close()
+
+	msg := fetchTxDetail(srv.Client(), srv.URL, "DIGEST123")
+	require.NoError(t, msg.err)
+	assert.Equal(t, "DIGEST123", msg.detail.Digest)
+	assert.Equal(t, "failure", msg.detail.Status)
+	assert.Equal(t, "MoveAbort", msg.detail.Error)
+	assert.Equal(t, "42", msg.detail.Checkpoint)
+	assert.Equal(t, 1, msg.detail.Created)
+	assert.Equal(t, 2, msg.detail.Mutated)
+	assert.Equal(t, []string{"JumpEvent"}, msg.detail.Events)
+
+	m := initialModel("docker", t.TempDir())
+	m.txFocus = true
+	m.txDetail = &msg.detail
+	out := m.renderRightContent(20)
+	assert.Contains(t, out, "MoveAbort")
+	assert.Contains(t, out, "1 created, 2 mutated, 0 deleted")
+}
+
+func TestFetchTxDetail_RPCError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"error":{"code":-32602,"message":"Could not find the referenced transaction"}}`))
+	}))
+	defer srv.Close()
+
+	msg := fetchTxDetail(srv.Client(), srv.URL, "MISSING")
+	require.Error(t, msg.err)
+	assert.Contains(t, msg.err.Error(), "Could not find")
+}
+
+func TestUpdate_LogBufferHonoursMaxLogLines(t *testing.T) {
+	var m tea.Model = model{maxLogLines: 3}
+	for i := 1; i <= 5; i++ {
+		m, _ = m.Update(LogMsg(fmt.Sprintf("line %d", i)))
+	}
+	assert.Equal(t, []string{"line 3", "line 4", "line 5"}, m.(model).logs)
+
+	m = model{}
+	for i := 0; i < defaultDashMaxLogLines+10; i++ {
+		m, _ = m.Update(LogMsg("x"))
+	}
+	assert.Len(t, m.(model).logs, defaultDashMaxLogLines, "an unset limit falls back to the default")
+}
+
+func TestUpdate_TickWhilePausedSkipsFetch(t *testing.T) {
+	m := model{refresh: time.Millisecond, paused: true}
+	_, cmd := m.Update(TickMsg(time.Now()))
+	require.NotNil(t, cmd)
+	_, isTick := cmd().(TickMsg)
+	assert.True(t, isTick, "paused dashboard should only schedule the next tick")
+}
+
+func TestFetchChainInfo_StalledRPCSkipsRemainingCalls(t *testing.T) {
+	release := make(chan struct{})
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		<-release
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	client := &http.Client{Timeout: 50 * time.Millisecond}
+	info := fetchChainInfo(context.Background(), client, srv.URL, 5)
+	assert.True(t, info.TimedOut)
+	assert.Equal(t, "Unresponsive", info.Checkpoint)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+// fakeSuiRPC answers each JSON-RPC method with the matching canned result.
+func fakeSuiRPC(t *testing.T, results map[string]string) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Method string `json:"method"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		result, ok := results[req.Method]
+		if !ok {
+			http.Error(w, "unexpected method "+req.Method, http.StatusBadRequest)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":%s}`, result)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestFetchChainInfo_ShapesTransactions(t *testing.T) {
+	ts := time.Now().Add(-90 * time.Second).UnixMilli()
+	srv := fakeSuiRPC(t, map[string]string{
+		"sui_getLatestCheckpointSequenceNumber": `"1234"`,
+		"sui_getTotalTransactionBlocks":         `"5678"`,
+		"sui_getLatestSuiSystemState":           `{"epoch":"7"}`,
+		"suix_queryTransactionBlocks": fmt.Sprintf(`{"data":[
+			{"digest":"D1","timestampMs":"%d",
+			 "transaction":{"data":{"sender":"0x1234567890abcdef1234","transaction":{"kind":"ProgrammableTransaction"}}},
+			 "effects":{"status":{"status":"success"},"gasUsed":{"computationCost":"1000000","storageCost":"2000000","storageRebate":"500000"}}},
+			{"digest":"D0","transaction":{"data":{"sender":"0xabc"}}}
+		]}`, ts),
+	})
+
+	info := fetchChainInfo(context.Background(), srv.Client(), srv.URL, 2)
+	assert.Equal(t, "1234", info.Checkpoint)
+	assert.Equal(t, "5678", info.TxCount)
+	assert.Equal(t, "7", info.Epoch)
+	assert.False(t, info.TimedOut)
+	require.Len(t, info.RecentTxs, 2)
+
+	tx := info.RecentTxs[0]
+	assert.Equal(t, "PrgTx", tx.Kind)
+	assert.Equal(t, "success", tx.Status)
+	assert.Equal(t, "2,500,000", tx.GasUsed)
+	assert.Equal(t, "1m", tx.Age)
+	assert.Equal(t, "0x1234..1234", tx.Sender)
+	assert.Equal(t, time.UnixMilli(ts), tx.Timestamp)
+
+	bare := info.RecentTxs[1]
+	assert.Equal(t, recentTx{Digest: "D0", Status: "?", Kind: "tx", Age: "-", Sender: "0xabc", GasUsed: "-"}, bare)
+}
+
+func TestFetchChainInfo_Offline(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	url := srv.URL
+	srv.Close()
+
+	info := fetchChainInfo(context.Background(), &http.Client{Timeout: time.Second}, url, 5)
+	assert.Equal(t, "Offline", info.Checkpoint)
+	assert.Equal(t, "-", info.TxCount)
+	assert.False(t, info.TimedOut)
+	assert.Empty(t, info.RecentTxs)
+}
+
+func TestFetchWorldEvents_ShapesEvents(t *testing.T) {
+	srv := fakeSuiRPC(t, map[string]string{
+		"suix_queryEvents": `{"data":[
+			{"id":{"txDigest":"D1","eventSeq":"0"},"packageId":"0xworld","transactionModule":"gate","sender":"0x1234567890abcdef1234",
+			 "type":"0xworld::gate::JumpEvent<0x2::sui::SUI>","parsedJson":{"gate":"0x9"}},
+			{"id":{"txDigest":"D2","eventSeq":"0"},"packageId":"0xother","transactionModule":"coin","type":"0xother::coin::Minted"}
+		]}`,
+	})
+
+	events := fetchWorldEvents(context.Background(), srv.Client(), srv.URL, "0xworld", "0xadmin", 5)
+	require.Len(t, events, 1)
+	assert.Equal(t, "JumpEvent", events[0].EventType)
+	assert.Equal(t, "gate", events[0].Module)
+	assert.Equal(t, "0x1234..1234", events[0].Sender)
+	assert.Equal(t, "-", events[0].Age)
+	assert.Equal(t, "0x9", events[0].ParsedJSON["gate"])
+}
+
+func TestApplyStats_RPCTimeoutsOpenBreaker(t *testing.T) {
+	m := initialModel("docker", t.TempDir())
+	for i := 0; i < dashboard.DefaultRPCBreakerThreshold; i++ {
+		assert.True(t, m.rpcBreaker.ShouldPoll(time.Now()))
+		m.applyStats(StatsMsg{Chain: chainStat{Checkpoint: "Unresponsive", Polled: true, TimedOut: true}})
+	}
+	assert.True(t, m.rpcBreaker.Open())
+	assert.False(t, m.rpcBreaker.ShouldPoll(time.Now()))
+
+	// A skipped refresh leaves the breaker untouched.
+	m.applyStats(StatsMsg{Chain: chainStat{Checkpoint: "Unresponsive"}})
+	assert.True(t, m.rpcBreaker.Open())
+
+	m.applyStats(StatsMsg{Chain: chainStat{Checkpoint: "100", Polled: true}})
+	assert.False(t, m.rpcBreaker.Open())
+}
+
+func TestApplyStats_IgnoresUserComposeOverride(t *testing.T) {
+	workspace := t.TempDir()
+	dockerDir := filepath.Join(workspace, "builder-scaffold", "docker")
+	require.NoError(t, os.MkdirAll(dockerDir, 0750))
+	require.NoError(t, os.WriteFile(filepath.Join(dockerDir, "docker-compose.override.yml"), []byte("services:\n  frontend:\n  postgres:\n"), 0600))
+
+	m := initialModel("docker", workspace)
+	m.applyStats(StatsMsg{Pg: containerStat{Status: "Running"}, Fe: containerStat{Status: "Stopped"}})
+
+	assert.True(t, m.graphqlOn)
+	assert.False(t, m.frontendOn, "a user-authored override must not mark the frontend as enabled")
+}
+
+func TestKeepSince(t *testing.T) {
+	now := time.Now()
+	txs := []recentTx{
+		{Digest: "new", Timestamp: now.Add(-time.Minute)},
+		{Digest: "old", Timestamp: now.Add(-time.Hour)},
+		{Digest: "unknown"},
+	}
+
+	kept, hidden := keepSince(txs, now.Add(-5*time.Minute), func(tx recentTx) time.Time { return tx.Timestamp })
+	assert.Equal(t, 1, hidden)
+	require.Len(t, kept, 2)
+	assert.Equal(t, "new", kept[0].Digest)
+	assert.Equal(t, "unknown", kept[1].Digest)
+}
+
+func TestApplyStats_SinceHidesOlderEntries(t *testing.T) {
+	now := time.Now()
+	m := initialModel("docker", t.TempDir())
+	m.since = 5 * time.Minute
+
+	m.applyStats(StatsMsg{
+		Chain: chainStat{RecentTxs: []recentTx{
+			{Digest: "a", Timestamp: now.Add(-time.Minute)},
+			{Digest: "b", Timestamp: now.Add(-10 * time.Minute)},
+		}},
+		Events: []worldEvent{
+			{EventType: "Old", Timestamp: now.Add(-time.Hour)},
+		},
+	})
+
+	assert.Len(t, m.recentTxs, 1)
+	assert.Equal(t, 1, m.hiddenTxs)
+	assert.Empty(t, m.worldEvents)
+	assert.Equal(t, 1, m.hiddenEvents)
+	assert.Contains(t, m.renderRightContent(20), "(1 older hidden)")
+}
+
 func TestInitialModel_HostFromConfig(t *testing.T) {
 	saved := config.Loaded
 	config.Loaded = &config.Config{Host: "0.0.0.0"}
@@ -543,6 +957,32 @@ func TestWriteEnvConfig_UrlsWithCustomHost(t *testing.T) {
 	assert.Contains(t, out, "http://172.0.0.1:5173")
 }
 
+func TestWriteEnvConfig_FrontendHealth(t *testing.T) {
+	for _, tt := range []struct {
+		healthy bool
+		want    string
+	}{{true, "UP"}, {false, "DOWN"}} {
+		m := model{host: "127.0.0.1", frontendOn: true, feHealthy: tt.healthy, width: 120, envVars: map[string]string{}}
+		var buf bytes.Buffer
+		m.writeEnvConfig(&buf, func(s string) string { return s })
+		assert.Contains(t, buf.String(), "http://localhost:5173 "+tt.want)
+	}
+}
+
+func TestProbeHTTP(t *testing.T) {
+	up := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer up.Close()
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer failing.Close()
+
+	client := &http.Client{Timeout: time.Second}
+	assert.True(t, probeHTTP(client, up.URL))
+	assert.False(t, probeHTTP(client, failing.URL))
+	assert.False(t, probeHTTP(client, "http://127.0.0.1:1"))
+}
+
 func TestWriteEnvConfig_GqlAndFeOff(t *testing.T) {
 	m := model{
 		host:       "127.0.0.1",
@@ -562,3 +1002,503 @@ func TestWriteEnvConfig_GqlAndFeOff(t *testing.T) {
 	assert.NotContains(t, out, "9125")
 	assert.NotContains(t, out, "5173")
 }
+
+func TestWriteEnvObjects_ShowsKnownTypes(t *testing.T) {
+	m := model{
+		worldObjs:     map[string]string{"governorCap": "0x222", "adminAcl": "0x333"},
+		worldObjTypes: map[string]string{"governorCap": "0x1234...cdef::access::GovernorCap"},
+	}
+	var buf bytes.Buffer
+	m.writeEnvObjects(&buf, func(s string) string { return s })
+
+	out := buf.String()
+	assert.Contains(t, out, "0x222 ")
+	assert.Contains(t, out, "access::GovernorCap")
+	assert.NotContains(t, out, "0x333 ", "objects without a known type have no trailing column")
+}
+
+func TestEnvOpenURL(t *testing.T) {
+	url, err := envOpenURL("explorer", "127.0.0.1")
+	require.NoError(t, err)
+	assert.Equal(t, "https://custom.suiscan.xyz/custom/home/?network=http%3A%2F%2Flocalhost%3A9000", url)
+
+	url, err = envOpenURL("frontend", "172.0.0.1")
+	require.NoError(t, err)
+	assert.Equal(t, "http://172.0.0.1:5173", url)
+
+	url, err = envOpenURL("graphql", "127.0.0.1")
+	require.NoError(t, err)
+	assert.Equal(t, "http://localhost:9125/graphql", url)
+
+	_, err = envOpenURL("nope", "127.0.0.1")
+	assert.Error(t, err)
+}
+
+func TestIsWorkspaceCommand(t *testing.T) {
+	assert.True(t, isWorkspaceCommand(envUpCmd))
+	assert.True(t, isWorkspaceCommand(envSuiResetCmd))
+	assert.True(t, isWorkspaceCommand(extensionInitCmd))
+	assert.False(t, isWorkspaceCommand(doctorCmd))
+	assert.False(t, isWorkspaceCommand(rootCmd))
+}
+
+func TestWorkspaceEnvOverride(t *testing.T) {
+	env := func(vars map[string]string) func(string) string {
+		return func(key string) string { return vars[key] }
+	}
+
+	got, ok := workspaceEnvOverride(false, env(map[string]string{"EFCTL_WORKSPACE": "/srv/ef"}))
+	assert.True(t, ok)
+	assert.Equal(t, "/srv/ef", got)
+
+	_, ok = workspaceEnvOverride(true, env(map[string]string{"EFCTL_WORKSPACE": "/srv/ef"}))
+	assert.False(t, ok, "an explicit --workspace flag must win over EFCTL_WORKSPACE")
+
+	_, ok = workspaceEnvOverride(false, env(nil))
+	assert.False(t, ok)
+
+	home, err := os.UserHomeDir()
+	require.NoError(t, err)
+	got, ok = workspaceEnvOverride(false, env(map[string]string{"EFCTL_WORKSPACE": "~/dev/ef"}))
+	assert.True(t, ok)
+	assert.Equal(t, filepath.Join(home, "dev", "ef"), got)
+}
+
+func TestColorFlagFromArgs(t *testing.T) {
+	mode, ok := colorFlagFromArgs([]string{"env", "status", "--color=never"})
+	assert.True(t, ok)
+	assert.Equal(t, "never", mode)
+
+	mode, ok = colorFlagFromArgs([]string{"--color", "always", "doctor"})
+	assert.True(t, ok)
+	assert.Equal(t, "always", mode)
+
+	_, ok = colorFlagFromArgs([]string{"env", "run", "--", "--color=never"})
+	assert.False(t, ok)
+
+	_, ok = colorFlagFromArgs([]string{"doctor"})
+	assert.False(t, ok)
+}
+
+// ── env status --format ────────────────────────────────────────────
+
+func TestRenderWorldExport_JSON(t *testing.T) {
+	world := status.WorldInfo{
+		PackageID: "0xPKG",
+		Objects:   map[string]string{"governorCap": "0xGOV"},
+		Addresses: map[string]string{"Admin": "0xA"},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, renderWorldExport(&buf, world, "json"))
+
+	var got worldExport
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &got))
+	assert.Equal(t, "0xPKG", got.PackageID)
+	assert.Equal(t, "0xGOV", got.Objects["governorCap"])
+	assert.Equal(t, "0xA", got.Addresses["Admin"])
+}
+
+func TestRenderWorldExport_JSONEmptyMaps(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, renderWorldExport(&buf, status.WorldInfo{}, "json"))
+	assert.Contains(t, buf.String(), `"objects": {}`)
+	assert.Contains(t, buf.String(), `"addresses": {}`)
+}
+
+func TestRenderWorldExport_CSV(t *testing.T) {
+	world := status.WorldInfo{
+		PackageID: "0xPKG",
+		Objects:   map[string]string{"objectRegistry": "0xREG", "adminAcl": "0xACL"},
+		Addresses: map[string]string{"Admin": "0xA"},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, renderWorldExport(&buf, world, "csv"))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Equal(t, []string{
+		"Section,Key,Value",
+		"package,World Package ID,0xPKG",
+		"object,adminAcl,0xACL",
+		"object,objectRegistry,0xREG",
+		"address,Admin,0xA",
+	}, lines)
+}
+
+func TestWritePublishResult(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, writePublishResult(&buf, builder.PublishResult{BuilderPackageID: "0xPKG", ExtensionConfigID: "0xCFG"}))
+	assert.JSONEq(t, `{"builderPackageId":"0xPKG","extensionConfigId":"0xCFG","createdObjects":[]}`, buf.String())
+
+	buf.Reset()
+	require.NoError(t, writePublishResult(&buf, builder.PublishResult{
+		BuilderPackageID: "0xPKG",
+		CreatedObjects:   []builder.CreatedObject{{Type: "0xPKG::config::AdminCap", ID: "0xCAP"}},
+	}))
+	assert.JSONEq(t, `{"builderPackageId":"0xPKG","extensionConfigId":"","createdObjects":[{"type":"0xPKG::config::AdminCap","id":"0xCAP"}]}`, buf.String())
+}
+
+func TestParseContainerFormat(t *testing.T) {
+	_, err := parseContainerFormat("yaml")
+	assert.Error(t, err)
+
+	_, err = parseContainerFormat("{{.Name")
+	assert.Error(t, err)
+
+	_, err = parseContainerFormat("{{.Name}}")
+	assert.NoError(t, err)
+}
+
+func TestRenderContainerExport(t *testing.T) {
+	containers := []status.ContainerStat{
+		{Name: "sui-playground", Status: "Running", CPU: "1.5%", Mem: "200MiB"},
+		{Name: "frontend", Status: "Stopped", CPU: "-", Mem: "-"},
+	}
+
+	tsv, err := parseContainerFormat("tsv")
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, renderContainerExport(&buf, containers, tsv))
+	assert.Equal(t, "sui-playground\tRunning\t1.5%\t200MiB\nfrontend\tStopped\t-\t-\n", buf.String())
+
+	custom, err := parseContainerFormat(`{{.Name}}\t{{.Status}}`)
+	require.NoError(t, err)
+	buf.Reset()
+	require.NoError(t, renderContainerExport(&buf, containers, custom))
+	assert.Equal(t, "sui-playground\tRunning\nfrontend\tStopped\n", buf.String())
+
+	unknown, err := parseContainerFormat("{{.Image}}")
+	require.NoError(t, err)
+	assert.Error(t, renderContainerExport(&buf, containers, unknown))
+}
+
+func TestBuildCallSnippets(t *testing.T) {
+	world := status.ObjectIDs{
+		PackageID: "0xPKG",
+		Objects:   map[string]string{"objectRegistry": "0xREG", "adminAcl": "0xACL", "customThing": "0xCUS"},
+	}
+
+	snippets := buildCallSnippets(world, "sui")
+	require.NotEmpty(t, snippets)
+	assert.Equal(t, "sui client object 0xPKG", snippets[0].Command)
+
+	var all []string
+	for _, s := range snippets {
+		all = append(all, s.Command)
+	}
+	joined := strings.Join(all, "\n")
+	assert.Contains(t, joined, "sui client object 0xACL --json")
+	assert.Contains(t, joined, "sui client object 0xCUS --json")
+	assert.Contains(t, joined, "sui client dynamic-field 0xREG")
+	assert.Contains(t, joined, "--package 0xPKG --module gate --function anchor")
+	assert.Contains(t, joined, "--args 0xREG <NETWORK_NODE_ID> <CHARACTER_ID> 0xACL")
+	assert.Contains(t, joined, "'vector<u8>:<LOCATION_HASH>'", "vector args must be quoted for the shell")
+}
+
+func TestBuildCallSnippets_SkipsCallsWithoutCoreObjects(t *testing.T) {
+	snippets := buildCallSnippets(status.ObjectIDs{PackageID: "0xPKG", Objects: map[string]string{}}, "sui")
+	require.Len(t, snippets, 1)
+	assert.Equal(t, "sui client object 0xPKG", snippets[0].Command)
+}
+
+func TestBuildCallSnippets_UsesSuiBinary(t *testing.T) {
+	snippets := buildCallSnippets(status.ObjectIDs{
+		PackageID: "0xPKG",
+		Objects:   map[string]string{"objectRegistry": "0xREG", "adminAcl": "0xACL"},
+	}, "/opt/sui/bin/sui")
+	for _, s := range snippets {
+		assert.True(t, strings.HasPrefix(s.Command, "/opt/sui/bin/sui client "), "unexpected command %q", s.Command)
+	}
+}
+
+func TestParseEnvAssignment(t *testing.T) {
+	key, val, err := parseEnvAssignment("SPONSOR_ADDRESSES=0xabc, 0xdef")
+	require.NoError(t, err)
+	assert.Equal(t, "SPONSOR_ADDRESSES", key)
+	assert.Equal(t, "0xabc, 0xdef", val)
+
+	key, val, err = parseEnvAssignment("TENANT=dev=1")
+	require.NoError(t, err)
+	assert.Equal(t, "TENANT", key)
+	assert.Equal(t, "dev=1", val)
+
+	_, _, err = parseEnvAssignment("ADMIN_ADDRESS=")
+	assert.NoError(t, err)
+
+	for _, bad := range []string{"NOEQUALS", "1KEY=x", "ADMIN_ADDRESS=nothex", "SPONSOR_ADDRESSES=0xabc,bad", "TENANT=a\nB=c"} {
+		_, _, err := parseEnvAssignment(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestValidateEnvMap(t *testing.T) {
+	env := map[string]string{
+		"ADMIN_ADDRESS":     "0xabc",
+		"ADMIN_PRIVATE_KEY": "not-a-key",
+		"SPONSOR_ADDRESSES": "",
+		"WORLD_PACKAGE_ID":  "\"0x123\"",
+		"SUI_RPC_URL":       "localhost:9000",
+		"TENANT":            "dev",
+	}
+
+	problems := validateEnvMap(env, []string{"ADMIN_ADDRESS", "SPONSOR_ADDRESSES"})
+	msgs := make([]string, 0, len(problems))
+	for _, p := range problems {
+		msgs = append(msgs, p.Error())
+	}
+	require.Len(t, msgs, 3, msgs)
+	assert.Equal(t, "SPONSOR_ADDRESSES is required but not set", msgs[0])
+	assert.Contains(t, msgs[1], "ADMIN_PRIVATE_KEY")
+	assert.Contains(t, msgs[2], "SUI_RPC_URL")
+}
+
+func TestValidateEnvMap_Clean(t *testing.T) {
+	env := map[string]string{
+		"ADMIN_ADDRESS":     "0xabc",
+		"ADMIN_PRIVATE_KEY": "suiprivkey1qzdlfxn2qa2lj5uprl8pyhexs02sg2wrhdy7qaq50cqgnffw4c2477kg9h3",
+		"SPONSOR_ADDRESSES": "0xabc,0xdef",
+	}
+	assert.Empty(t, validateEnvMap(env, config.DefaultWorldEnvRequired))
+}
+
+func TestValidatePrivateKey(t *testing.T) {
+	assert.NoError(t, validatePrivateKey("suiprivkey1qzdlfxn2qa2lj5uprl8pyhexs02sg2wrhdy7qaq50cqgnffw4c2477kg9h3"))
+	for _, k := range []string{
+		"suiprivkey1ABC",
+		// well-formed characters, but the checksum does not match
+		"suiprivkey1qzdlfxn2qa2lj5uprl8pyhexs02sg2wrhdy7qaq50cqgnffw4c2477kg9h4",
+		// valid checksum, but the payload is too short for a key
+		"suiprivkey1qqrswpc8qurswpc8qurswpc8qurswpc8qu9razqp",
+	} {
+		assert.Error(t, validatePrivateKey(k), k)
+	}
+}
+
+func TestFollowFile_PicksUpAppendedLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "deploy.log")
+	require.NoError(t, os.WriteFile(path, []byte("first\n"), 0o600))
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	pr, pw := io.Pipe()
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- followFile(ctx, file, pw, 10*time.Millisecond)
+		_ = pw.Close()
+	}()
+
+	reader := bufio.NewReader(pr)
+	line, err := reader.ReadString('\n')
+	require.NoError(t, err)
+	assert.Equal(t, "first\n", line)
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o600)
+	require.NoError(t, err)
+	_, err = f.WriteString("second\n")
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	line, err = reader.ReadString('\n')
+	require.NoError(t, err)
+	assert.Equal(t, "second\n", line)
+
+	cancel()
+	go func() { _, _ = io.Copy(io.Discard, pr) }()
+	require.NoError(t, <-done)
+}
+
+func TestFormatLogLine(t *testing.T) {
+	assert.Equal(t, "[db] ready", formatLogLine("[db]", "ready", false))
+	assert.Equal(t, "[frontend] VITE ready", formatLogLine("[frontend]", "\x1b[32mVITE\x1b[39m ready", false))
+
+	stamped := formatLogLine("[db]", "ready", true)
+	require.Len(t, stamped, len(dashboard.LogTimestampLayout)+len(" [db] ready"))
+	_, err := time.Parse(dashboard.LogTimestampLayout, stamped[:len(dashboard.LogTimestampLayout)])
+	assert.NoError(t, err)
+	assert.True(t, strings.HasSuffix(stamped, " [db] ready"))
+}
+
+func TestHandleMainKeyMsg_ETogglesEventsPanel(t *testing.T) {
+	m := initialModel("docker", t.TempDir())
+	m.width, m.height = 160, 50
+	m.worldEvents = []worldEvent{{EventType: "JumpEvent", Module: "gate", Age: "1s"}}
+	assert.Contains(t, m.View(), "World Events (1)")
+
+	updated, _ := m.handleMainKeyMsg(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("e")})
+	hidden := updated.(model)
+	assert.False(t, hidden.showEvents)
+	assert.NotContains(t, hidden.View(), "World Events")
+
+	updated, _ = hidden.handleMainKeyMsg(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("e")})
+	assert.Contains(t, updated.(model).View(), "World Events (1)")
+}
+
+func TestDashProgramOptions(t *testing.T) {
+	assert.Len(t, dashProgramOptions(false), 2)
+	assert.Len(t, dashProgramOptions(true), 1)
+}
+
+func TestView_CompactForTinyTerminal(t *testing.T) {
+	m := initialModel("docker", t.TempDir())
+	m.width, m.height = 30, 8
+	m.suiStat.Status = "Running"
+	m.logs = []string{"[docker] a very long log line that does not fit in thirty columns"}
+
+	out := m.View()
+	assert.NotContains(t, out, "╭")
+	assert.Contains(t, out, "sui:      Running")
+	lines := strings.Split(out, "\n")
+	assert.LessOrEqual(t, len(lines), m.height)
+	for _, line := range lines {
+		assert.LessOrEqual(t, lipgloss.Width(line), m.width, line)
+	}
+
+	m.width, m.height = minDashWidth, minDashHeight
+	assert.NotContains(t, m.View(), "Resize to")
+}
+
+func TestWriteDashCrashLog(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "logs")
+	path, err := writeDashCrashLog(dir, "boom", []byte("goroutine 1 [running]:"))
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, dashCrashLogName), path)
+
+	_, err = writeDashCrashLog(dir, "again", nil)
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "dashboard panic: boom")
+	assert.Contains(t, string(data), "goroutine 1 [running]:")
+	assert.Contains(t, string(data), "dashboard panic: again")
+	if runtime.GOOS != "windows" {
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	}
+}
+
+func TestLogDashPanic_RecordsAndRepanics(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("USERPROFILE", home)
+	t.Cleanup(func() { dashCrashLog = atomic.Value{} })
+
+	assert.PanicsWithValue(t, "render failed", func() {
+		defer logDashPanic()
+		panic("render failed")
+	})
+
+	path, ok := dashCrashLog.Load().(string)
+	require.True(t, ok)
+	assert.Equal(t, filepath.Join(home, ".efctl", dashCrashLogName), path)
+}
+
+func TestOpenDashDebugLog(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), ".efctl")
+	f, err := openDashDebugLog(dir)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		log.SetOutput(os.Stderr)
+		log.SetPrefix("")
+	})
+	require.NoError(t, f.Close())
+	assert.FileExists(t, filepath.Join(dir, "dash-debug.log"))
+
+	blocker := filepath.Join(t.TempDir(), "file")
+	require.NoError(t, os.WriteFile(blocker, nil, 0o600))
+	_, err = openDashDebugLog(filepath.Join(blocker, "sub"))
+	assert.Error(t, err)
+}
+
+func TestFetchStats_SkippedChainPoll(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses the true binary as a stand-in container engine")
+	}
+	// World discovery still runs; keep it off the real localhost ports.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+	oldURL := dashRPCURL
+	dashRPCURL = srv.URL
+	defer func() { dashRPCURL = oldURL }()
+
+	msg := fetchStats(context.Background(), "true", t.TempDir(), 5, 5, "", 100*time.Millisecond, false)
+	assert.Equal(t, "Unresponsive", msg.Chain.Checkpoint)
+	assert.False(t, msg.Chain.Polled)
+	assert.Empty(t, msg.Events)
+}
+
+func TestParseContainerStats_FakeEngine(t *testing.T) {
+	engine, _ := testutil.FakeEngine(t, "sui-playground\t12.34%\t100MiB / 2GiB\nefctl-postgres\t0.50%\t50MiB / 2GiB\nunrelated\t1%\t1MiB / 2GiB\nmalformed line\n", 0)
+
+	sui, pg, fe := parseContainerStats(context.Background(), engine)
+	assert.Equal(t, "Running", sui.Status)
+	assert.Equal(t, "12%", sui.CPU)
+	assert.Equal(t, "Running", pg.Status)
+	assert.Equal(t, "Stopped", fe.Status)
+	assert.Equal(t, "-", fe.CPU)
+}
+
+func TestParseContainerStats_EngineFailure(t *testing.T) {
+	engine, _ := testutil.FakeEngine(t, "Cannot connect to the Docker daemon", 1)
+
+	sui, pg, fe := parseContainerStats(context.Background(), engine)
+	for _, s := range []containerStat{sui, pg, fe} {
+		assert.Equal(t, containerStat{Status: "Stopped", CPU: "-", Mem: "-"}, s)
+	}
+}
+
+func TestRenderCleanupPlan(t *testing.T) {
+	var buf bytes.Buffer
+	renderCleanupPlan(&buf, []container.CleanupTarget{
+		{Kind: "container", Name: container.ContainerSuiPlayground, Exists: true},
+		{Kind: "volume", Name: container.VolumePgData, Exists: true},
+		{Kind: "image", Name: container.ImageSuiDevOld},
+	})
+
+	out := buf.String()
+	assert.Contains(t, out, container.VolumePgData)
+	assert.Contains(t, out, container.ImageSuiDevOld)
+	assert.Contains(t, out, "Dry run: 2 of 3 resources exist and would be removed. Nothing was removed.")
+}
+
+func TestWriteNewEvents_OldestFirstAndDeduped(t *testing.T) {
+	events := []status.WorldEvent{
+		{TxDigest: "D2", EventSeq: "0", Type: "0xw::gate::JumpEvent"},
+		{TxDigest: "D1", EventSeq: "1", Type: "0xw::gate::Linked"},
+		{TxDigest: "D1", EventSeq: "0", Type: "0xw::gate::Created"},
+	}
+	seen := map[string]bool{"D1:0": true}
+
+	var buf bytes.Buffer
+	n, err := writeNewEvents(&buf, events, seen)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+	assert.Contains(t, lines[0], `"txDigest":"D1","eventSeq":"1"`)
+	assert.Contains(t, lines[1], `"txDigest":"D2"`)
+
+	n, err = writeNewEvents(&buf, events, seen)
+	require.NoError(t, err)
+	assert.Zero(t, n, "events written once are not written again")
+}
+
+func TestReadEventIDs(t *testing.T) {
+	seen, err := readEventIDs(filepath.Join(t.TempDir(), "missing.jsonl"))
+	require.NoError(t, err)
+	assert.Empty(t, seen)
+
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	content := `{"txDigest":"D1","eventSeq":"0","type":"x"}` + "\nnot json\n" + `{"txDigest":"D2","eventSeq":"3"}` + "\n"
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+
+	seen, err = readEventIDs(path)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{"D1:0": true, "D2:3": true}, seen)
+}
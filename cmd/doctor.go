@@ -1,18 +1,31 @@
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
+	"os"
 	"path/filepath"
 	"strings"
 
 	"efctl/pkg/config"
 	"efctl/pkg/doctor"
 	"efctl/pkg/env"
+	"efctl/pkg/sui"
+	"efctl/pkg/ui"
 
 	"github.com/spf13/cobra"
 )
 
-var doctorWorkspace string
+var (
+	doctorWorkspace string
+	doctorJSON      bool
+	doctorFix       bool
+	doctorSwitchEnv bool
+)
+
+// doctorExit terminates the process when checks fail; tests replace it.
+var doctorExit = os.Exit
 
 var doctorCmd = &cobra.Command{
 	Use:   "doctor",
@@ -20,7 +33,13 @@ var doctorCmd = &cobra.Command{
 	Long: `Prints a non-destructive summary of the local environment useful for debugging
 and bug reports, including: efctl version, OS details, container runtime, Node.js,
 git, the state of running containers, port availability, and the git ref of any
-checked-out builder-scaffold and world-contracts repositories.`,
+checked-out builder-scaffold and world-contracts repositories.
+
+With --fix, doctor first attempts safe repairs for common issues and reports
+what it did: converting CRLF shell scripts to LF, re-applying the Dockerfile and
+entrypoint.sh patches, recreating a missing ef-localhost sui environment, and
+re-importing the world-contracts/.env keys. The active sui environment is only
+switched to ef-localhost with --switch-sui-env.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		prereqs := env.CheckPrerequisites()
 
@@ -35,6 +54,10 @@ checked-out builder-scaffold and world-contracts repositories.`,
 			doctorWorkspace = abs
 		}
 
+		if doctorFix {
+			printFixResults(doctor.Fix(doctor.FixOptions{Workspace: doctorWorkspace, SwitchSuiEnv: doctorSwitchEnv}))
+		}
+
 		r := doctor.Gather(doctor.Options{
 			Workspace:    doctorWorkspace,
 			Version:      Version,
@@ -46,7 +69,21 @@ checked-out builder-scaffold and world-contracts repositories.`,
 			Config:       config.Loaded,
 		})
 
-		printDoctorReport(r)
+		checks := r.Checks()
+		if doctorJSON {
+			if err := renderDoctorChecksJSON(os.Stdout, checks); err != nil {
+				ui.Error.Println("Failed to encode checks: " + err.Error())
+				doctorExit(1)
+				return
+			}
+		} else {
+			printDoctorReport(r)
+			printChecksSection(checks)
+		}
+
+		if !doctor.Passed(checks) {
+			doctorExit(1)
+		}
 	},
 }
 
@@ -146,6 +183,9 @@ func printSuiSection(r *doctor.Report) {
 		fmt.Printf(doctorFmt, "sui faucet url:", r.Sui.ActiveEnvFaucetUrl)
 	} else {
 		fmt.Printf(doctorFmt, "sui client:", "not found")
+		for _, line := range sui.LookupDiagnostics() {
+			fmt.Printf("  %s\n", line)
+		}
 	}
 	fmt.Println()
 }
@@ -168,6 +208,35 @@ func printConfigSection(r *doctor.Report) {
 	}
 }
 
+func printChecksSection(checks []doctor.Check) {
+	fmt.Println()
+	for _, c := range checks {
+		result := "ok"
+		if !c.OK {
+			result = "FAIL"
+		}
+		fmt.Printf(doctorFmt, "check "+c.Name+":", result+" ("+c.Detail+")")
+	}
+}
+
+func printFixResults(results []doctor.FixResult) {
+	for _, r := range results {
+		if r.Err != nil {
+			fmt.Printf(doctorFmt, "fix "+r.Name+":", "FAIL ("+r.Err.Error()+")")
+			continue
+		}
+		fmt.Printf(doctorFmt, "fix "+r.Name+":", r.Action)
+	}
+	fmt.Println()
+}
+
+// renderDoctorChecksJSON writes the checks as an indented JSON array.
+func renderDoctorChecksJSON(w io.Writer, checks []doctor.Check) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(checks)
+}
+
 func yesNo(value bool) string {
 	if value {
 		return "yes"
@@ -222,6 +291,10 @@ func repoLabel(r doctor.RepoInfo) string {
 }
 
 func init() {
-	doctorCmd.Flags().StringVarP(&doctorWorkspace, "workspace", "w", ".", "Path to the workspace directory")
+	doctorCmd.Flags().StringVarP(&doctorWorkspace, "workspace", "w", ".", "Path to the workspace directory (overrides EFCTL_WORKSPACE)")
+	doctorCmd.Flags().BoolVar(&doctorJSON, "json", false, "Print the pass/fail checks as JSON instead of the full report")
+	doctorCmd.Flags().BoolVar(&doctorFix, "fix", false, "Attempt safe automatic repairs (line endings, patches, sui env, keys) before diagnosing")
+	doctorCmd.Flags().BoolVar(&doctorSwitchEnv, "switch-sui-env", false, "With --fix, also make ef-localhost the active sui environment")
+	doctorCmd.MarkFlagsMutuallyExclusive("json", "fix")
 	rootCmd.AddCommand(doctorCmd)
 }
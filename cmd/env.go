@@ -13,6 +13,6 @@ var envCmd = &cobra.Command{
 }
 
 func init() {
-	envCmd.PersistentFlags().StringVarP(&workspacePath, "workspace", "w", ".", "Path to the workspace directory")
+	envCmd.PersistentFlags().StringVarP(&workspacePath, "workspace", "w", ".", "Path to the workspace directory (overrides EFCTL_WORKSPACE)")
 	rootCmd.AddCommand(envCmd)
 }
@@ -4,7 +4,6 @@ import (
 	"bufio"
 	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"net"
 	"net/http"
@@ -12,14 +11,18 @@ import (
 	"os/exec"
 	"path/filepath"
 	"regexp"
-	"strconv"
+	"runtime/debug"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"efctl/pkg/config"
 	"efctl/pkg/container"
 	"efctl/pkg/dashboard"
 	"efctl/pkg/env"
+	"efctl/pkg/httpclient"
+	"efctl/pkg/secrets"
 	"efctl/pkg/status"
 	"efctl/pkg/sui"
 	"efctl/pkg/ui"
@@ -29,11 +32,71 @@ import (
 	"github.com/spf13/cobra"
 )
 
+// defaultDashFetchLimit is the number of recent transactions and events fetched per refresh.
+const defaultDashFetchLimit = 20
+
+// defaultDashRefresh is how often the dashboard polls containers and the chain.
+const defaultDashRefresh = 2 * time.Second
+
+// minDashWidth and minDashHeight are the smallest terminal size the bordered
+// dashboard layout renders in; smaller terminals get a compact summary.
+const (
+	minDashWidth  = 40
+	minDashHeight = 12
+)
+
+// defaultDashMaxLogLines is how many container log lines the dashboard keeps
+// for scrolling back.
+const defaultDashMaxLogLines = 500
+
+// maxDashFetchLimit is the largest page size accepted by the Sui JSON-RPC query methods.
+const maxDashFetchLimit = 50
+
+// dashRPCURL is the JSON-RPC endpoint the dashboard polls. Discovery derives
+// the GraphQL endpoint from it. Tests point it at an httptest server.
+var dashRPCURL = "http://localhost:9000"
+
+var (
+	envDashTxLimit       int
+	envDashEventsLimit   int
+	envDashRefresh       time.Duration
+	envDashSince         time.Duration
+	envDashRPCTimeout    time.Duration
+	envDashLogTimestamps bool
+	envDashNoAltScreen   bool
+	envDashMaxLogLines   int
+)
+
 var envDashCmd = &cobra.Command{
 	Use:   "dash",
 	Short: "Launch the environment dashboard",
 	Long:  `Launches an interactive, responsive terminal dashboard for the EVE Frontier local development environment.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		for _, limit := range []struct {
+			flag  string
+			value int
+		}{{"--tx-limit", envDashTxLimit}, {"--events-limit", envDashEventsLimit}} {
+			if limit.value < 1 || limit.value > maxDashFetchLimit {
+				return fmt.Errorf("%s must be between 1 and %d, got %d", limit.flag, maxDashFetchLimit, limit.value)
+			}
+		}
+
+		if envDashRefresh < 500*time.Millisecond {
+			return fmt.Errorf("--refresh must be at least 500ms, got %s", envDashRefresh)
+		}
+
+		if envDashSince < 0 {
+			return fmt.Errorf("--since must not be negative, got %s", envDashSince)
+		}
+
+		if envDashRPCTimeout <= 0 {
+			return fmt.Errorf("--rpc-poll-timeout must be positive, got %s", envDashRPCTimeout)
+		}
+
+		if envDashMaxLogLines < 1 {
+			return fmt.Errorf("--max-log-lines must be at least 1, got %d", envDashMaxLogLines)
+		}
+
 		res := env.CheckPrerequisites()
 		engine, _ := res.Engine()
 		if engine == "" {
@@ -41,40 +104,53 @@ var envDashCmd = &cobra.Command{
 		}
 
 		m := initialModel(engine, workspacePath)
+		m.refresh = envDashRefresh
+		m.txLimit = envDashTxLimit
+		m.eventsLimit = envDashEventsLimit
+		m.since = envDashSince
+		m.rpcTimeout = envDashRPCTimeout
+		m.maxLogLines = envDashMaxLogLines
+
+		// Cancelled on exit so in-flight log streams and world discovery stop.
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		m.ctx = ctx
 
 		// Only enable debug logging when explicitly requested;
 		// log to a user-owned directory with restrictive permissions.
 		if debugMode, _ := cmd.Flags().GetBool("debug"); debugMode {
-			homeDir, err := os.UserHomeDir()
-			if err == nil {
-				logDir := filepath.Join(homeDir, ".efctl")
-				_ = os.MkdirAll(logDir, 0700)
-				logPath := filepath.Join(logDir, "dash-debug.log")
-				f, fErr := tea.LogToFile(logPath, "debug")
-				if fErr == nil {
-					defer f.Close()
-					// Restrict file permissions to owner-only
-					_ = os.Chmod(logPath, 0600)
-				}
+			if f, err := openDashDebugLog(dashLogDir()); err != nil {
+				ui.Warn.Println("Dashboard debug logging disabled: " + err.Error())
+			} else {
+				defer f.Close()
 			}
 		}
 
-		p := tea.NewProgram(m, tea.WithAltScreen(), tea.WithMouseCellMotion())
+		p := tea.NewProgram(m, dashProgramOptions(envDashNoAltScreen)...)
 
 		// Start log collection
-		ctx, cancel := context.WithCancel(context.Background())
-		defer cancel()
-		go collectLogs(ctx, p, engine, workspacePath)
+		goDash(p, func() { collectLogs(ctx, p, engine, workspacePath, envDashLogTimestamps) })
 
-		if _, err := p.Run(); err != nil {
-			return err
+		_, err := p.Run()
+		if crashLog, ok := dashCrashLog.Load().(string); ok {
+			cancel()
+			ui.Error.Println("The dashboard crashed and the terminal was restored. Details were written to " + crashLog + "; please include them when reporting the issue.")
+			os.Exit(1)
 		}
-		return nil
+		return err
 	},
 }
 
 func init() {
 	envDashCmd.Flags().Bool("debug", false, "Enable debug logging to ~/.efctl/dash-debug.log")
+	envDashCmd.Flags().DurationVar(&envDashRefresh, "refresh", defaultDashRefresh, "Interval between dashboard refreshes (e.g. 5s); press space to pause")
+	envDashCmd.Flags().IntVar(&envDashTxLimit, "tx-limit", defaultDashFetchLimit, fmt.Sprintf("Number of recent transactions to fetch per refresh (1-%d)", maxDashFetchLimit))
+	envDashCmd.Flags().IntVar(&envDashEventsLimit, "events-limit", defaultDashFetchLimit, fmt.Sprintf("Number of recent world events to fetch per refresh (1-%d)", maxDashFetchLimit))
+	envDashCmd.Flags().DurationVar(&envDashSince, "since", 0, "Only show transactions and world events newer than this age (e.g. 5m); 0 shows all")
+	envDashCmd.Flags().BoolVar(&envDashNoAltScreen, "no-altscreen", false, "Render inline instead of on the terminal's alternate screen (for tmux, screen, or flaky terminals)")
+	envDashCmd.Flags().BoolVar(&envDashLogTimestamps, "log-timestamps", false, "Prefix log lines with the local time they were received")
+	envDashCmd.Flags().IntVar(&envDashMaxLogLines, "max-log-lines", defaultDashMaxLogLines, "Number of container log lines kept for scrolling back")
+	envDashCmd.Flags().DurationVar(&envDashRPCTimeout, "rpc-poll-timeout", status.DefaultRPCTimeout, "Timeout for each Sui RPC call; polling backs off after repeated timeouts")
 	envCmd.AddCommand(envDashCmd)
 }
 
@@ -108,12 +184,13 @@ type containerStat struct {
 }
 
 type recentTx struct {
-	Digest  string
-	Status  string
-	Kind    string
-	Age     string
-	Sender  string
-	GasUsed string
+	Digest    string
+	Status    string
+	Kind      string
+	Age       string
+	Sender    string
+	GasUsed   string
+	Timestamp time.Time // zero when the RPC did not report one
 }
 
 type chainStat struct {
@@ -121,6 +198,8 @@ type chainStat struct {
 	Epoch      string
 	TxCount    string
 	RecentTxs  []recentTx
+	Polled     bool // false when the RPC breaker skipped this refresh
+	TimedOut   bool // the RPC accepted the connection but did not answer in time
 }
 
 type worldEvent struct {
@@ -128,6 +207,7 @@ type worldEvent struct {
 	Module     string
 	Sender     string
 	Age        string
+	Timestamp  time.Time // zero when the RPC did not report one
 	ParsedJSON map[string]interface{}
 }
 
@@ -148,12 +228,14 @@ type StatsMsg struct {
 	Admin          string
 	EnvVars        map[string]string
 	WorldObjs      map[string]string // component → object ID from extracted-object-ids.json
+	WorldObjTypes  map[string]string // component → shortened Move type, once the RPC has answered
 	Addresses      map[string]string // role → address (Admin, Player A, Player B, Sponsor)
 	WorldPkgID     string
 	DiscoveredPkgs []statPackage
 	Events         []worldEvent
 	Assemblies     []statAssembly
 	Extensions     []statExtension
+	FeHealthy      bool // frontend dev server answered an HTTP GET
 }
 
 type statPackage struct {
@@ -174,8 +256,8 @@ type statExtension struct {
 	Type string
 }
 
-func tickCmd() tea.Cmd {
-	return tea.Tick(2*time.Second, func(t time.Time) tea.Msg {
+func tickCmd(interval time.Duration) tea.Cmd {
+	return tea.Tick(interval, func(t time.Time) tea.Msg {
 		return TickMsg(t)
 	})
 }
@@ -197,9 +279,12 @@ func extractAdmin(workspace string) string {
 	}
 	// Fallback to derive from private key
 	reKey := regexp.MustCompile(`(?m)^ADMIN_PRIVATE_KEY=(suiprivkey[a-z0-9]+)`)
-	keyMatches := reKey.FindStringSubmatch(string(data))
-	if len(keyMatches) > 1 {
-		if addr, err := sui.DeriveAddressFromPrivateKey(keyMatches[1]); err == nil {
+	key := secrets.Lookup(secrets.Default, envPath, "ADMIN_PRIVATE_KEY")
+	if keyMatches := reKey.FindStringSubmatch(string(data)); len(keyMatches) > 1 {
+		key = keyMatches[1]
+	}
+	if key != "" {
+		if addr, err := sui.DeriveAddressFromPrivateKey(key); err == nil {
 			return addr
 		}
 	}
@@ -223,8 +308,15 @@ func extractEnvVars(workspace string) map[string]string {
 			continue
 		}
 		parts := strings.SplitN(line, "=", 2)
-		if len(parts) == 2 && parts[1] != "" {
-			result[parts[0]] = parts[1]
+		if len(parts) != 2 {
+			continue
+		}
+		value := parts[1]
+		if value == "" && strings.HasSuffix(parts[0], "_PRIVATE_KEY") {
+			value = secrets.Lookup(secrets.Default, envPath, parts[0])
+		}
+		if value != "" {
+			result[parts[0]] = value
 		}
 	}
 	return result
@@ -323,179 +415,75 @@ func rpcBaseURL(host string) string {
 	return "http://" + resolveDisplayHost(host)
 }
 
-func fetchChainInfo(client *http.Client) chainStat {
-	info := chainStat{Checkpoint: "Offline", TxCount: "-", Epoch: "-"}
-
-	// Checkpoint
-	rpcPayload := `{"jsonrpc":"2.0","id":1,"method":"sui_getLatestCheckpointSequenceNumber","params":[]}`
-	rpcReq, _ := http.NewRequest("POST", "http://localhost:9000", strings.NewReader(rpcPayload))
-	rpcReq.Header.Set("Content-Type", "application/json")
-	if resp, err := client.Do(rpcReq); err == nil { // #nosec G704 -- hardcoded localhost URL
-		var res struct {
-			Result string `json:"result"`
-		}
-		_ = json.NewDecoder(resp.Body).Decode(&res)
-		info.Checkpoint = res.Result
-		_ = resp.Body.Close()
-	}
-
-	// Total transactions
-	rpcPayloadTx := `{"jsonrpc":"2.0","id":1,"method":"sui_getTotalTransactionBlocks","params":[]}`
-	rpcReqTx, _ := http.NewRequest("POST", "http://localhost:9000", strings.NewReader(rpcPayloadTx))
-	rpcReqTx.Header.Set("Content-Type", "application/json")
-	if resp, err := client.Do(rpcReqTx); err == nil { // #nosec G704 -- hardcoded localhost URL
-		var res struct {
-			Result string `json:"result"`
-		}
-		_ = json.NewDecoder(resp.Body).Decode(&res)
-		info.TxCount = res.Result
-		_ = resp.Body.Close()
-	}
-
-	// Epoch
-	rpcPayloadEpoch := `{"jsonrpc":"2.0","id":1,"method":"sui_getLatestSuiSystemState","params":[]}`
-	rpcReqEpoch, _ := http.NewRequest("POST", "http://localhost:9000", strings.NewReader(rpcPayloadEpoch))
-	rpcReqEpoch.Header.Set("Content-Type", "application/json")
-	if resp, err := client.Do(rpcReqEpoch); err == nil { // #nosec G704 -- hardcoded localhost URL
-		var res struct {
-			Result map[string]interface{} `json:"result"`
-		}
-		_ = json.NewDecoder(resp.Body).Decode(&res)
-		if ep, ok := res.Result["epoch"].(string); ok {
-			info.Epoch = ep
-		}
-		_ = resp.Body.Close()
-	}
-
-	// Recent transactions (descending order, up to 20)
-	rpcPayloadRecent := `{"jsonrpc":"2.0","id":1,"method":"suix_queryTransactionBlocks","params":[{"options":{"showInput":true,"showEffects":true}},null,20,true]}`
-	rpcReqRecent, _ := http.NewRequest("POST", "http://localhost:9000", strings.NewReader(rpcPayloadRecent))
-	rpcReqRecent.Header.Set("Content-Type", "application/json")
-	if resp, err := client.Do(rpcReqRecent); err == nil { // #nosec G704 -- hardcoded localhost URL
-		var res struct {
-			Result struct {
-				Data []struct {
-					Digest      string `json:"digest"`
-					TimestampMs string `json:"timestampMs"`
-					Transaction struct {
-						Data struct {
-							Sender      string `json:"sender"`
-							Transaction struct {
-								Kind string `json:"kind"`
-							} `json:"transaction"`
-						} `json:"data"`
-					} `json:"transaction"`
-					Effects struct {
-						Status struct {
-							Status string `json:"status"`
-						} `json:"status"`
-						GasUsed struct {
-							ComputationCost string `json:"computationCost"`
-							StorageCost     string `json:"storageCost"`
-							StorageRebate   string `json:"storageRebate"`
-						} `json:"gasUsed"`
-					} `json:"effects"`
-				} `json:"data"`
-			} `json:"result"`
-		}
-		_ = json.NewDecoder(resp.Body).Decode(&res)
-		for _, tx := range res.Result.Data {
-			d := tx.Digest
-			if len(d) > 16 {
-				d = d[:8] + ".." + d[len(d)-4:]
-			}
-			age := "-"
-			if ms, err := strconv.ParseInt(tx.TimestampMs, 10, 64); err == nil {
-				age = formatAge(time.Since(time.UnixMilli(ms)))
-			}
-			status := tx.Effects.Status.Status
-			if status == "" {
-				status = "?"
-			}
-			kind := tx.Transaction.Data.Transaction.Kind
-			if kind == "" {
-				kind = "tx"
-			}
-			sender := tx.Transaction.Data.Sender
-			if len(sender) > 14 {
-				sender = sender[:6] + ".." + sender[len(sender)-4:]
-			}
-			gas := formatGas(
-				tx.Effects.GasUsed.ComputationCost,
-				tx.Effects.GasUsed.StorageCost,
-				tx.Effects.GasUsed.StorageRebate,
-			)
-			info.RecentTxs = append(info.RecentTxs, recentTx{
-				Digest:  d,
-				Status:  status,
-				Kind:    shortKind(kind),
-				Age:     age,
-				Sender:  sender,
-				GasUsed: gas,
-			})
-		}
-		_ = resp.Body.Close()
-	}
+// fetchChainInfo polls chain health and recent transactions from rpcURL
+// through pkg/status and shapes them for the chain and transactions panels.
+func fetchChainInfo(ctx context.Context, client *http.Client, rpcURL string, txLimit int) chainStat {
+	info := chainStat{Checkpoint: "Offline", TxCount: "-", Epoch: "-", Polled: true}
 
-	return info
-}
+	health := status.QueryChainHealth(ctx, client, rpcURL)
+	switch health.RPCStatus {
+	case "Unresponsive":
+		// A wedged node would stall every remaining call for the full timeout.
+		info.Checkpoint = "Unresponsive"
+		info.TimedOut = true
+		return info
+	case "Healthy":
+		info.Checkpoint = health.Checkpoint
+	}
+	info.TxCount, info.Epoch = health.TxCount, health.Epoch
 
-// parseContainerStats parses docker stats output into sui, postgres, and frontend container stats.
-func parseContainerStats(engine string) (sui, pg, fe containerStat) {
-	sui = containerStat{Status: "Stopped", CPU: "-", Mem: "-"}
-	pg = containerStat{Status: "Stopped", CPU: "-", Mem: "-"}
-	fe = containerStat{Status: "Stopped", CPU: "-", Mem: "-"}
-	out, err := exec.Command(engine, "stats", "--no-stream", "--format", "{{.Name}}\t{{.CPUPerc}}\t{{.MemUsage}}").Output() // #nosec G204
+	// Recent transactions (descending order, up to txLimit)
+	txs, err := status.QueryRecentTransactions(ctx, client, rpcURL, txLimit)
 	if err != nil {
-		return
+		return info
 	}
-	for _, l := range strings.Split(string(out), "\n") {
-		parts := strings.Split(l, "\t")
-		if len(parts) < 3 {
-			continue
+	for _, tx := range txs {
+		age := "-"
+		ts := tx.Time()
+		if !ts.IsZero() {
+			age = formatAge(time.Since(ts))
 		}
-		name := strings.TrimSpace(parts[0])
-		cpu := formatCPU(parts[1])
-		mem := formatMem(parts[2])
-		if name == container.ContainerSuiPlayground {
-			sui = containerStat{Status: "Running", CPU: cpu, Mem: mem}
+		txStatus := tx.Status
+		if txStatus == "" {
+			txStatus = "?"
 		}
-		if name == container.ContainerPostgres {
-			pg = containerStat{Status: "Running", CPU: cpu, Mem: mem}
+		kind := tx.Kind
+		if kind == "" {
+			kind = "tx"
 		}
-		if name == container.ContainerFrontend {
-			fe = containerStat{Status: "Running", CPU: cpu, Mem: mem}
+		sender := tx.Sender
+		if len(sender) > 14 {
+			sender = sender[:6] + ".." + sender[len(sender)-4:]
 		}
+		info.RecentTxs = append(info.RecentTxs, recentTx{
+			Digest:    tx.Digest,
+			Status:    txStatus,
+			Kind:      shortKind(kind),
+			Age:       age,
+			Sender:    sender,
+			GasUsed:   formatGas(tx.Gas.ComputationCost, tx.Gas.StorageCost, tx.Gas.StorageRebate),
+			Timestamp: ts,
+		})
 	}
-	return
+
+	return info
 }
 
-// extractWorldObjects reads the extracted-object-ids.json and returns world objects and package ID.
-func extractWorldObjects(workspace string) (objs map[string]string, pkgID string) {
-	objs = make(map[string]string)
-	extractFile := filepath.Join(workspace, "world-contracts", "deployments", "localnet", "extracted-object-ids.json")
-	data, err := os.ReadFile(extractFile) // #nosec G304 -- path constructed from known workspace prefix
-	if err != nil {
-		return
-	}
-	var objMap map[string]interface{}
-	if json.Unmarshal(data, &objMap) != nil {
-		return
-	}
-	world, ok := objMap["world"].(map[string]interface{})
-	if !ok {
-		return
+// parseContainerStats reads sui, postgres, and frontend container stats
+// through pkg/status and rounds CPU and memory for display.
+func parseContainerStats(ctx context.Context, engine string) (sui, pg, fe containerStat) {
+	stats := status.GatherContainerStats(ctx, engine)
+	byName := make(map[string]containerStat, len(stats))
+	for _, st := range stats {
+		byName[st.Name] = containerStat{Status: st.Status, CPU: formatCPU(st.CPU), Mem: formatMem(st.Mem)}
 	}
-	for k, v := range world {
-		if str, ok := v.(string); ok {
-			if k == "packageId" {
-				pkgID = str
-			} else {
-				objs[k] = str
-			}
-		}
-	}
-	return
+	return byName[container.ContainerSuiPlayground], byName[container.ContainerPostgres], byName[container.ContainerFrontend]
+}
+
+// extractWorldObjects reads the extracted-object-ids.json and returns world objects and package ID.
+func extractWorldObjects(workspace string) (map[string]string, string) {
+	ids, _ := status.ReadObjectIDs(workspace, "localnet")
+	return ids.Objects, ids.PackageID
 }
 
 // buildAddresses assembles the role→address map from env vars and derived keys.
@@ -503,94 +491,102 @@ func buildAddresses(admin string, envVars map[string]string) map[string]string {
 	return dashboard.BuildAddresses(admin, envVars, deriveAddress)
 }
 
-func fetchStats(engine string, workspace string) StatsMsg {
+func fetchStats(ctx context.Context, engine string, workspace string, txLimit, eventsLimit int, frontendURL string, rpcTimeout time.Duration, pollChain bool) StatsMsg {
 	msg := StatsMsg{}
-	msg.Sui, msg.Pg, msg.Fe = parseContainerStats(engine)
-
-	client := &http.Client{Timeout: 1 * time.Second}
-	msg.Chain = fetchChainInfo(client)
+	client := httpclient.NewEndpoint(rpcTimeout)
 
-	// Use pkg/status logic for world info
-	st := status.Gather(engine, workspace, "http://localhost:9000")
-	msg.WorldObjs = st.World.Objects
-	msg.WorldPkgID = st.World.PackageID
-	for _, p := range st.World.DiscoveredPkgs {
-		msg.DiscoveredPkgs = append(msg.DiscoveredPkgs, statPackage{ID: p.ID, Version: p.Version, Owner: p.Owner})
-	}
-	msg.Addresses = st.World.Addresses
-	msg.Admin = msg.Addresses["Admin"]
-	msg.EnvVars = extractEnvVars(workspace)
+	// Container stats, chain info, and world info are independent, so fetch
+	// them concurrently. The refresh still waits for the slowest of the three
+	// before sending StatsMsg. Each goroutine writes disjoint fields of msg.
+	var wg sync.WaitGroup
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		msg.Sui, msg.Pg, msg.Fe = parseContainerStats(ctx, engine)
+		// The Vite dev server only answers once pnpm install has finished, so the
+		// container can be running long before the dApp is ready to open.
+		if msg.Fe.Status == "Running" && frontendURL != "" {
+			msg.FeHealthy = probeHTTP(client, frontendURL)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		if pollChain {
+			msg.Chain = fetchChainInfo(ctx, client, dashRPCURL, txLimit)
+		} else {
+			msg.Chain = chainStat{Checkpoint: "Unresponsive", TxCount: "-", Epoch: "-"}
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		// Use pkg/status logic for world info; container and chain stats are
+		// gathered separately, so only the world section is needed.
+		world := status.GatherWorldInfo(ctx, workspace, dashRPCURL)
+		msg.WorldObjs = world.Objects
+		msg.WorldObjTypes = world.ObjectTypes
+		msg.WorldPkgID = world.PackageID
+		for _, p := range world.DiscoveredPkgs {
+			msg.DiscoveredPkgs = append(msg.DiscoveredPkgs, statPackage{ID: p.ID, Version: p.Version, Owner: p.Owner})
+		}
+		msg.Addresses = world.Addresses
+		msg.Admin = msg.Addresses["Admin"]
+		msg.EnvVars = extractEnvVars(workspace)
 
-	for _, a := range st.World.Assemblies {
-		msg.Assemblies = append(msg.Assemblies, statAssembly{Name: a.Name, ID: a.ID, Type: a.Type})
-	}
-	for _, e := range st.World.Extensions {
-		msg.Extensions = append(msg.Extensions, statExtension{Name: e.Name, ID: e.ID, Type: e.Type})
-	}
+		for _, a := range world.Assemblies {
+			msg.Assemblies = append(msg.Assemblies, statAssembly{Name: a.Name, ID: a.ID, Type: a.Type})
+		}
+		for _, e := range world.Extensions {
+			msg.Extensions = append(msg.Extensions, statExtension{Name: e.Name, ID: e.ID, Type: e.Type})
+		}
+	}()
+	wg.Wait()
 
-	if msg.WorldPkgID != "" && msg.Admin != "" && msg.Admin != "Unknown" && msg.Admin != "Not Found" {
-		msg.Events = fetchWorldEvents(client, msg.WorldPkgID, msg.Admin)
+	// Events need the world package and admin, and are skipped when the chain
+	// poll timed out.
+	if pollChain && !msg.Chain.TimedOut && msg.WorldPkgID != "" && msg.Admin != "" && msg.Admin != "Unknown" && msg.Admin != "Not Found" {
+		msg.Events = fetchWorldEvents(ctx, client, dashRPCURL, msg.WorldPkgID, msg.Admin, eventsLimit)
 	}
 
 	return msg
 }
 
-// fetchWorldEvents queries recent events emitted by the world package.
+// probeHTTP reports whether url answers a GET with a non-5xx status.
+func probeHTTP(client *http.Client, url string) bool {
+	resp, err := client.Get(url) // #nosec G107 -- url is the dashboard's own frontend endpoint
+	if err != nil {
+		return false
+	}
+	_ = resp.Body.Close()
+	return resp.StatusCode < http.StatusInternalServerError
+}
+
+// fetchWorldEvents queries recent events emitted by the world package from rpcURL.
 // It queries events by Sender (admin) and filters to those matching the world package ID.
-func fetchWorldEvents(client *http.Client, pkgID string, admin string) []worldEvent {
+func fetchWorldEvents(ctx context.Context, client *http.Client, rpcURL, pkgID, admin string, limit int) []worldEvent {
 	var events []worldEvent
 
 	// Query events by sender (admin deploys and interacts with world contracts)
-	payload := fmt.Sprintf(`{"jsonrpc":"2.0","id":1,"method":"suix_queryEvents","params":[{"Sender":"%s"},null,20,true]}`, admin)
-	req, _ := http.NewRequest("POST", "http://localhost:9000", strings.NewReader(payload))
-	req.Header.Set("Content-Type", "application/json")
-	resp, err := client.Do(req) // #nosec G704 -- hardcoded localhost URL
+	res, err := status.QueryWorldEvents(ctx, client, rpcURL, pkgID, admin, limit)
 	if err != nil {
 		return events
 	}
-	var res struct {
-		Result struct {
-			Data []struct {
-				ID struct {
-					TxDigest string `json:"txDigest"`
-				} `json:"id"`
-				PackageID   string                 `json:"packageId"`
-				Module      string                 `json:"transactionModule"`
-				Sender      string                 `json:"sender"`
-				Type        string                 `json:"type"`
-				TimestampMs string                 `json:"timestampMs"`
-				ParsedJSON  map[string]interface{} `json:"parsedJson"`
-			} `json:"data"`
-		} `json:"result"`
-	}
-	_ = json.NewDecoder(resp.Body).Decode(&res)
-	_ = resp.Body.Close()
 
-	for _, ev := range res.Result.Data {
-		// Only include events from the world package
-		if ev.PackageID != pkgID {
-			continue
-		}
+	for _, ev := range res {
 		age := "-"
-		if ms, err := strconv.ParseInt(ev.TimestampMs, 10, 64); err == nil {
-			age = formatAge(time.Since(time.UnixMilli(ms)))
+		ts := ev.Time()
+		if !ts.IsZero() {
+			age = formatAge(time.Since(ts))
 		}
 		sender := ev.Sender
 		if len(sender) > 14 {
 			sender = sender[:6] + ".." + sender[len(sender)-4:]
 		}
-		eventType := ev.Type
-		if idx := strings.LastIndex(eventType, "::"); idx >= 0 {
-			eventType = eventType[idx+2:]
-		}
-		if idx := strings.Index(eventType, "<"); idx >= 0 {
-			eventType = eventType[:idx]
-		}
 		events = append(events, worldEvent{
-			EventType:  eventType,
+			EventType:  shortEventType(ev.Type),
 			Module:     ev.Module,
 			Sender:     sender,
 			Age:        age,
+			Timestamp:  ts,
 			ParsedJSON: ev.ParsedJSON,
 		})
 	}
@@ -598,6 +594,71 @@ func fetchWorldEvents(client *http.Client, pkgID string, admin string) []worldEv
 	return events
 }
 
+// shortEventType strips the type parameters and package/module path from a
+// fully-qualified Move event type. Type parameters go first so a generic such
+// as Event<0x2::sui::SUI> is not cut at the inner "::".
+func shortEventType(eventType string) string {
+	if idx := strings.Index(eventType, "<"); idx >= 0 {
+		eventType = eventType[:idx]
+	}
+	if idx := strings.LastIndex(eventType, "::"); idx >= 0 {
+		eventType = eventType[idx+2:]
+	}
+	return eventType
+}
+
+// shortDigest abbreviates a transaction digest for display.
+func shortDigest(d string) string {
+	if len(d) > 16 {
+		return d[:8] + ".." + d[len(d)-4:]
+	}
+	return d
+}
+
+// txDetail is the expanded view of one transaction, fetched on demand when a
+// row in the Recent Transactions list is opened.
+type txDetail struct {
+	Digest     string
+	Status     string
+	Error      string
+	Sender     string
+	Checkpoint string
+	GasUsed    string
+	Created    int
+	Mutated    int
+	Deleted    int
+	Events     []string
+}
+
+type txDetailMsg struct {
+	detail txDetail
+	err    error
+}
+
+// fetchTxDetail loads effects and events for digest from rpcURL via sui_getTransactionBlock.
+func fetchTxDetail(client *http.Client, rpcURL, digest string) txDetailMsg {
+	r, err := status.QueryTransaction(context.Background(), client, rpcURL, digest)
+	if err != nil {
+		return txDetailMsg{err: err}
+	}
+
+	detail := txDetail{
+		Digest:     r.Digest,
+		Status:     r.Status,
+		Error:      r.Error,
+		Sender:     r.Sender,
+		Checkpoint: r.Checkpoint,
+		GasUsed:    formatGas(r.Gas.ComputationCost, r.Gas.StorageCost, r.Gas.StorageRebate),
+		Created:    r.Created,
+		Mutated:    r.Mutated,
+		Deleted:    r.Deleted,
+	}
+	for _, t := range r.EventTypes {
+		detail.Events = append(detail.Events, shortEventType(t))
+	}
+	return txDetailMsg{detail: detail}
+}
+
 // deriveAddress derives a Sui address from a bech32 private key without
 // shelling out to the sui CLI.
 func deriveAddress(privkey string) string {
@@ -608,9 +669,112 @@ func deriveAddress(privkey string) string {
 	return addr
 }
 
-// streamContainerLogs starts tailing a container's logs and sends lines with the given prefix.
-func streamContainerLogs(ctx context.Context, p *tea.Program, engine, containerName, prefix string) {
+// dashProgramOptions returns the bubbletea options for the dashboard. The
+// alternate screen is skipped when noAltScreen is set, for terminals and
+// multiplexers that render it badly.
+func dashProgramOptions(noAltScreen bool) []tea.ProgramOption {
+	opts := []tea.ProgramOption{tea.WithMouseCellMotion()}
+	if !noAltScreen {
+		opts = append(opts, tea.WithAltScreen())
+	}
+	return opts
+}
+
+// dashCrashLogName is the file in dashLogDir that dashboard panics are written to.
+const dashCrashLogName = "dash-crash.log"
+
+// dashCrashLog holds the crash log path once a dashboard panic was recorded.
+var dashCrashLog atomic.Value
+
+// dashLogDir returns the directory for the dashboard debug and crash logs,
+// falling back to the OS temp directory when there is no home directory.
+func dashLogDir() string {
+	if home, err := os.UserHomeDir(); err == nil {
+		return filepath.Join(home, ".efctl")
+	}
+	return os.TempDir()
+}
+
+// openDashDebugLog points bubbletea's debug log at dash-debug.log in dir,
+// readable only by the owner. The caller closes the returned file.
+func openDashDebugLog(dir string) (*os.File, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	logPath := filepath.Join(dir, "dash-debug.log")
+	f, err := tea.LogToFile(logPath, "debug")
+	if err != nil {
+		return nil, err
+	}
+	_ = os.Chmod(logPath, 0600)
+	return f, nil
+}
+
+// writeDashCrashLog appends a recovered panic and its stack trace to the
+// crash log in dir and returns the log path.
+func writeDashCrashLog(dir string, r any, stack []byte) (string, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, dashCrashLogName)
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600) // #nosec G304 -- fixed name in the efctl log directory
+	if err != nil {
+		return "", err
+	}
+	if _, err := fmt.Fprintf(f, "%s efctl %s dashboard panic: %v\n\n%s\n", time.Now().Format(time.RFC3339), Version, r, stack); err != nil {
+		_ = f.Close()
+		return "", err
+	}
+	return path, f.Close()
+}
+
+// recordDashPanic writes a recovered panic to the crash log so RunE can
+// point at it once the terminal is restored.
+func recordDashPanic(r any) {
+	path, err := writeDashCrashLog(dashLogDir(), r, debug.Stack())
+	if err != nil {
+		path = "(crash log could not be written: " + err.Error() + ")"
+	}
+	dashCrashLog.Store(path)
+}
+
+// logDashPanic records a panic in Update or View and re-panics so bubbletea
+// still restores the terminal before Run returns.
+func logDashPanic() {
+	if r := recover(); r != nil {
+		recordDashPanic(r)
+		panic(r)
+	}
+}
+
+// goDash runs fn in a goroutine. bubbletea cannot see panics outside its own
+// loop, so a panic is recorded here and the program killed, which restores
+// the terminal instead of leaving it in raw mode.
+func goDash(p *tea.Program, fn func()) {
 	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				recordDashPanic(r)
+				p.Kill()
+			}
+		}()
+		fn()
+	}()
+}
+
+// formatLogLine joins a source prefix and a log line stripped of its own
+// escape codes, prepending the local time when timestamps is set.
+func formatLogLine(prefix, text string, timestamps bool) string {
+	line := prefix + " " + dashboard.StripANSI(text)
+	if timestamps {
+		line = dashboard.StampLogLine(line, time.Now())
+	}
+	return line
+}
+
+// streamContainerLogs starts tailing a container's logs and sends lines with the given prefix.
+func streamContainerLogs(ctx context.Context, p *tea.Program, engine, containerName, prefix string, timestamps bool) {
+	goDash(p, func() {
 		for {
 			select {
 			case <-ctx.Done():
@@ -623,7 +787,7 @@ func streamContainerLogs(ctx context.Context, p *tea.Program, engine, containerN
 					if err := cmd.Start(); err == nil {
 						scanner := bufio.NewScanner(stdout)
 						for scanner.Scan() {
-							p.Send(LogMsg(fmt.Sprintf("%s %s", prefix, scanner.Text())))
+							p.Send(LogMsg(formatLogLine(prefix, scanner.Text(), timestamps)))
 						}
 						_ = cmd.Wait() // Reclaim process
 					}
@@ -631,23 +795,23 @@ func streamContainerLogs(ctx context.Context, p *tea.Program, engine, containerN
 				time.Sleep(2 * time.Second)
 			}
 		}
-	}()
+	})
 }
 
-func collectLogs(ctx context.Context, p *tea.Program, engine, workspace string) {
+func collectLogs(ctx context.Context, p *tea.Program, engine, workspace string, timestamps bool) {
 	// 1. Sui container logs
-	streamContainerLogs(ctx, p, engine, container.ContainerSuiPlayground, "[docker]")
+	streamContainerLogs(ctx, p, engine, container.ContainerSuiPlayground, "[docker]", timestamps)
 
 	// 2. Database container logs
-	streamContainerLogs(ctx, p, engine, container.ContainerPostgres, "[db]")
+	streamContainerLogs(ctx, p, engine, container.ContainerPostgres, "[db]", timestamps)
 
 	// 3. Frontend container logs
-	streamContainerLogs(ctx, p, engine, container.ContainerFrontend, "[frontend]")
+	streamContainerLogs(ctx, p, engine, container.ContainerFrontend, "[frontend]", timestamps)
 
 	// 4. Deploy logs
-	deployLogPath := filepath.Join(workspace, "world-contracts", "deployments", "localnet", "deploy.log")
+	deployLogPath := status.DeployLogPath(workspace)
 	// Try to start immediately or retry if missing (during env up)
-	go func() {
+	goDash(p, func() {
 		for {
 			select {
 			case <-ctx.Done():
@@ -674,7 +838,7 @@ func collectLogs(ctx context.Context, p *tea.Program, engine, workspace string)
 									time.Sleep(500 * time.Millisecond) // wait for more
 									continue
 								}
-								p.Send(LogMsg(fmt.Sprintf("[deploy] %s", strings.TrimSpace(line))))
+								p.Send(LogMsg(formatLogLine("[deploy]", strings.TrimSpace(line), timestamps)))
 							}
 						}
 					}
@@ -682,7 +846,7 @@ func collectLogs(ctx context.Context, p *tea.Program, engine, workspace string)
 				time.Sleep(2 * time.Second)
 			}
 		}
-	}()
+	})
 }
 
 type model struct {
@@ -700,35 +864,41 @@ type model struct {
 	adminAddr      string
 	envVars        map[string]string
 	worldObjs      map[string]string
+	worldObjTypes  map[string]string
 	addresses      map[string]string
 	worldPkgID     string
 	discoveredPkgs []statPackage
 	assemblies     []statAssembly
 	extensions     []statExtension
 	logs           []string
-	logScroll      int          // lines scrolled up from the bottom (0 = tailing)
-	graphqlOn      bool         // whether GraphQL/Indexer is currently enabled
-	frontendOn     bool         // whether the frontend dApp container is enabled
-	worldEvents    []worldEvent // recent events from the world package
-	restarting     bool         // whether we are in the interactive restart menu
-	host           string       // bind address for container ports (from config, default 127.0.0.1)
+	logScroll      int           // lines scrolled up from the bottom (0 = tailing)
+	graphqlOn      bool          // whether GraphQL/Indexer is currently enabled
+	frontendOn     bool          // whether the frontend dApp container is enabled
+	worldEvents    []worldEvent  // recent events from the world package
+	showEvents     bool          // whether the events panel is shown when there are events (toggled with e)
+	restarting     bool          // whether we are in the interactive restart menu
+	host           string        // bind address for container ports (from config, default 127.0.0.1)
+	txLimit        int           // recent transactions fetched per refresh
+	eventsLimit    int           // recent world events fetched per refresh
+	refresh        time.Duration // interval between stats refreshes
+	paused         bool          // whether periodic stats refreshes are paused
+	frontendURL    string        // URL probed for frontend dev server health
+	feHealthy      bool          // whether the frontend dev server is answering
+	since          time.Duration // hide txs/events older than this (0 = show all)
+	hiddenTxs      int           // recent txs hidden by since
+	hiddenEvents   int           // world events hidden by since
+	txFocus        bool          // whether up/down move the recent-tx cursor instead of scrolling logs
+	txCursor       int           // selected row in the recent-tx list
+	txDetail       *txDetail     // expanded transaction, nil when closed
+	txDetailErr    string        // error from the last detail fetch
+	txLoading      bool          // whether a detail fetch is in flight
+	rpcTimeout     time.Duration // per-call timeout for chain RPC requests
+	maxLogLines    int           // log lines kept for scrollback; 0 means defaultDashMaxLogLines
+	rpcBreaker     dashboard.RPCBreaker
+	ctx            context.Context // cancelled when the dashboard exits; nil means context.Background
 }
 
 func initialModel(engine string, workspace string) model {
-	// Detect whether GraphQL is currently enabled by checking the override file
-	gqlOn := false
-	feOn := false
-	overridePath := filepath.Join(workspace, "builder-scaffold", "docker", "docker-compose.override.yml")
-	if data, err := os.ReadFile(overridePath); err == nil { // #nosec G304 -- path constructed from known workspace prefix
-		content := string(data)
-		if strings.Contains(content, "postgres:") || strings.Contains(content, "SUI_GRAPHQL_ENABLED") {
-			gqlOn = true
-		}
-		if strings.Contains(content, "frontend:") {
-			feOn = true
-		}
-	}
-
 	// Resolve host from config (defaults to 127.0.0.1)
 	host := "127.0.0.1"
 	if config.Loaded != nil {
@@ -736,28 +906,48 @@ func initialModel(engine string, workspace string) model {
 	}
 
 	return model{
-		engine:     engine,
-		workspace:  workspace,
-		startTime:  time.Now(),
-		suiStat:    containerStat{Status: "Checking...", CPU: "-", Mem: "-"},
-		pgStat:     containerStat{Status: "Checking...", CPU: "-", Mem: "-"},
-		feStat:     containerStat{Status: "Checking...", CPU: "-", Mem: "-"},
-		adminAddr:  "Checking...",
-		graphqlOn:  gqlOn,
-		frontendOn: feOn,
-		host:       host,
+		engine:      engine,
+		workspace:   workspace,
+		startTime:   time.Now(),
+		suiStat:     containerStat{Status: "Checking...", CPU: "-", Mem: "-"},
+		pgStat:      containerStat{Status: "Checking...", CPU: "-", Mem: "-"},
+		feStat:      containerStat{Status: "Checking...", CPU: "-", Mem: "-"},
+		adminAddr:   "Checking...",
+		host:        host,
+		txLimit:     defaultDashFetchLimit,
+		eventsLimit: defaultDashFetchLimit,
+		refresh:     defaultDashRefresh,
+		frontendURL: "http://" + resolveDisplayHost(host) + ":5173",
+		rpcTimeout:  status.DefaultRPCTimeout,
+		maxLogLines: defaultDashMaxLogLines,
+		rpcBreaker:  dashboard.NewRPCBreaker(),
+		showEvents:  true,
 	}
 }
 
 func (m model) Init() tea.Cmd {
 	return tea.Batch(
-		tickCmd(),
-		func() tea.Msg { return fetchStats(m.engine, m.workspace) },
+		tickCmd(m.refresh),
+		m.fetchStatsCmd(),
 		tea.SetWindowTitle("efctl dashboard"),
 	)
 }
 
+// fetchStatsCmd gathers a stats refresh in the background, skipping the chain
+// while the RPC breaker is backing off from an unresponsive node.
+func (m model) fetchStatsCmd() tea.Cmd {
+	pollChain := m.rpcBreaker.ShouldPoll(time.Now())
+	ctx := m.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return func() tea.Msg {
+		return fetchStats(ctx, m.engine, m.workspace, m.txLimit, m.eventsLimit, m.frontendURL, m.rpcTimeout, pollChain)
+	}
+}
+
 func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	defer logDashPanic()
 	switch msg := msg.(type) {
 	case tea.MouseMsg:
 		m.handleMouseScroll(msg)
@@ -767,12 +957,26 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.width = msg.Width
 		m.height = msg.Height
 	case TickMsg:
+		// Keep ticking while paused so resuming needs no extra bookkeeping;
+		// log streaming is independent of the tick and keeps flowing.
+		if m.paused {
+			return m, tickCmd(m.refresh)
+		}
 		return m, tea.Batch(
-			tickCmd(),
-			func() tea.Msg { return fetchStats(m.engine, m.workspace) },
+			tickCmd(m.refresh),
+			m.fetchStatsCmd(),
 		)
 	case StatsMsg:
 		m.applyStats(msg)
+	case txDetailMsg:
+		m.txLoading = false
+		if msg.err != nil {
+			m.txDetailErr = msg.err.Error()
+			return m, nil
+		}
+		m.txDetailErr = ""
+		detail := msg.detail
+		m.txDetail = &detail
 	case restartUpMsg:
 		return m, tea.ExecProcess(msg.upCmd, func(err error) tea.Msg {
 			if err != nil {
@@ -782,8 +986,12 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		})
 	case LogMsg:
 		m.logs = append(m.logs, string(msg))
-		if len(m.logs) > 500 {
-			m.logs = m.logs[len(m.logs)-500:]
+		limit := m.maxLogLines
+		if limit <= 0 {
+			limit = defaultDashMaxLogLines
+		}
+		if len(m.logs) > limit {
+			m.logs = m.logs[len(m.logs)-limit:]
 		}
 	}
 	return m, nil
@@ -820,9 +1028,53 @@ func (m model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	if m.restarting {
 		return m.handleRestartKeyMsg(msg)
 	}
+	if m.txFocus {
+		return m.handleTxKeyMsg(msg)
+	}
 	return m.handleMainKeyMsg(msg)
 }
 
+// handleTxKeyMsg handles keys while the recent-tx list has focus. With a
+// detail panel open, esc closes it; otherwise esc or t leaves tx focus.
+func (m model) handleTxKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+	case "q":
+		if m.txDetail == nil && m.txDetailErr == "" {
+			return m, tea.Quit
+		}
+		m.txDetail, m.txDetailErr = nil, ""
+	case "esc", "t":
+		if m.txDetail != nil || m.txDetailErr != "" {
+			m.txDetail, m.txDetailErr = nil, ""
+			return m, nil
+		}
+		m.txFocus = false
+	case "up", "k":
+		if m.txDetail == nil && m.txCursor > 0 {
+			m.txCursor--
+		}
+	case "down", "j":
+		if m.txDetail == nil && m.txCursor < len(m.recentTxs)-1 {
+			m.txCursor++
+		}
+	case "enter":
+		if m.txCursor >= len(m.recentTxs) || m.txLoading {
+			return m, nil
+		}
+		digest := m.recentTxs[m.txCursor].Digest
+		m.txLoading = true
+		m.txDetailErr = ""
+		return m, func() tea.Msg {
+			return fetchTxDetail(httpclient.NewEndpoint(5*time.Second), dashRPCURL, digest)
+		}
+	case " ", "space":
+		m.paused = !m.paused
+	}
+	return m, nil
+}
+
 func (m model) handleRestartKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	switch msg.String() {
 	case "q", "esc":
@@ -864,6 +1116,18 @@ func (m model) handleMainKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	case "r":
 		m.restarting = true
 		return m, nil
+	case " ", "space":
+		m.paused = !m.paused
+		return m, nil
+	case "t":
+		if len(m.recentTxs) > 0 {
+			m.txFocus = true
+			m.txCursor = 0
+		}
+		return m, nil
+	case "e":
+		m.showEvents = !m.showEvents
+		return m, nil
 	case "d":
 		return m.handleEnvDown()
 	case "g":
@@ -955,30 +1219,56 @@ func (m model) handleEnableFrontend() (tea.Model, tea.Cmd) {
 
 // applyStats updates the model with fresh stats data.
 func (m *model) applyStats(msg StatsMsg) {
+	if msg.Chain.Polled {
+		m.rpcBreaker.Record(msg.Chain.TimedOut, time.Now(), m.refresh)
+	}
 	m.suiStat = msg.Sui
 	m.pgStat = msg.Pg
 	m.feStat = msg.Fe
 	m.chainInfo = msg.Chain
 	m.recentTxs = msg.Chain.RecentTxs
+	m.worldEvents = msg.Events
+	m.hiddenTxs, m.hiddenEvents = 0, 0
+	if m.since > 0 {
+		cutoff := time.Now().Add(-m.since)
+		m.recentTxs, m.hiddenTxs = keepSince(m.recentTxs, cutoff, func(tx recentTx) time.Time { return tx.Timestamp })
+		m.worldEvents, m.hiddenEvents = keepSince(m.worldEvents, cutoff, func(ev worldEvent) time.Time { return ev.Timestamp })
+	}
+	if m.txCursor >= len(m.recentTxs) {
+		m.txCursor = max(0, len(m.recentTxs)-1)
+	}
+	if len(m.recentTxs) == 0 && m.txDetail == nil {
+		m.txFocus = false
+	}
 	m.objectTrackers = msg.Objects
 	m.adminAddr = msg.Admin
 	m.envVars = msg.EnvVars
 	m.worldObjs = msg.WorldObjs
+	m.worldObjTypes = msg.WorldObjTypes
 	m.addresses = msg.Addresses
 	m.worldPkgID = msg.WorldPkgID
 	m.discoveredPkgs = msg.DiscoveredPkgs
-	m.worldEvents = msg.Events
 	m.assemblies = msg.Assemblies
 	m.extensions = msg.Extensions
-	overridePath := filepath.Join(m.workspace, "builder-scaffold", "docker", "docker-compose.override.yml")
-	if data, err := os.ReadFile(overridePath); err == nil { // #nosec G304
-		content := string(data)
-		m.graphqlOn = strings.Contains(content, "postgres:") || strings.Contains(content, "SUI_GRAPHQL_ENABLED")
-		m.frontendOn = strings.Contains(content, "frontend:")
-	} else {
-		m.graphqlOn = false
-		m.frontendOn = false
+	m.feHealthy = msg.FeHealthy
+	// Optional services are efctl-managed containers, so their presence is the
+	// source of truth; builder-scaffold/docker/docker-compose.override.yml
+	// belongs to the user and is never read.
+	m.graphqlOn = msg.Pg.Status == "Running"
+	m.frontendOn = msg.Fe.Status == "Running"
+}
+
+// keepSince drops items older than cutoff and returns how many were dropped.
+// Items without a timestamp are kept.
+func keepSince[T any](items []T, cutoff time.Time, timestamp func(T) time.Time) ([]T, int) {
+	kept := make([]T, 0, len(items))
+	for _, item := range items {
+		if ts := timestamp(item); !ts.IsZero() && ts.Before(cutoff) {
+			continue
+		}
+		kept = append(kept, item)
 	}
+	return kept, len(items) - len(kept)
 }
 
 // fileExists returns true if the path exists.
@@ -1069,16 +1359,20 @@ func buildBottomBorderWithJunction(totalW, leftW int, footer string) string {
 }
 
 func (m model) View() string {
+	defer logDashPanic()
 	if m.width == 0 {
 		return "Initializing..."
 	}
+	if m.width < minDashWidth || m.height < minDashHeight {
+		return m.renderCompact()
+	}
 
 	header := m.renderHeader()
 	headerH := lipgloss.Height(header)
 
 	// ── Layout geometry ──
 	leftInner, rightInner, logInner := m.panelWidths()
-	hasEvents := len(m.worldEvents) > 0
+	hasEvents := m.showEvents && len(m.worldEvents) > 0
 
 	// Vertical budget
 	available := m.height - headerH
@@ -1180,6 +1474,9 @@ func (m model) renderHeader() string {
 		feStatus = "fe:ON"
 	}
 	headerTitle := fmt.Sprintf(" efctl dashboard │ sui:%s  db:%s  %s  %s │ Uptime: %v ", suiUp, dbUp, gqlStatus, feStatus, uptime)
+	if m.paused {
+		headerTitle += "│ PAUSED "
+	}
 	padLen := m.width - lipgloss.Width(headerTitle)
 	if padLen < 0 {
 		padLen = 0
@@ -1187,6 +1484,37 @@ func (m model) renderHeader() string {
 	return headerStyle.Width(m.width).Render(headerTitle + strings.Repeat(" ", padLen))
 }
 
+// renderCompact renders a plain single-column summary for terminals too small
+// for the bordered layout.
+func (m model) renderCompact() string {
+	lines := []string{
+		"efctl dashboard",
+		"sui:      " + m.suiStat.Status,
+		"postgres: " + m.pgStat.Status,
+	}
+	if m.isFrontendEnabled() {
+		lines = append(lines, "frontend: "+m.feStat.Status)
+	}
+	if m.chainInfo.Checkpoint != "" {
+		lines = append(lines, "checkpoint: "+m.chainInfo.Checkpoint)
+	}
+	if m.paused {
+		lines = append(lines, "PAUSED")
+	}
+	if n := len(m.logs); n > 0 {
+		lines = append(lines, m.logs[n-1])
+	}
+	lines = append(lines, fmt.Sprintf("Resize to %dx%d for the full view · q quit", minDashWidth, minDashHeight))
+
+	if m.height > 0 && len(lines) > m.height {
+		lines = lines[:m.height]
+	}
+	for i, line := range lines {
+		lines[i] = truncateToWidth(line, m.width)
+	}
+	return strings.Join(lines, "\n")
+}
+
 // panelWidths returns the inner widths for left, right, and full-width panels.
 func (m model) panelWidths() (leftInner, rightInner, logInner int) {
 	leftInner = max((m.width-3)/2, 1)
@@ -1254,6 +1582,9 @@ func (m model) writeBottomSection(out *strings.Builder, hasEvents bool, botRows,
 
 	if hasEvents {
 		eventsTitle := fmt.Sprintf("World Events (%d)", len(m.worldEvents))
+		if m.hiddenEvents > 0 {
+			eventsTitle = fmt.Sprintf("World Events (%d, %d older hidden)", len(m.worldEvents), m.hiddenEvents)
+		}
 		if m.logScroll > 0 {
 			logTitle = fmt.Sprintf("Logs ‖ PAUSED (↑%d)", m.logScroll)
 		}
@@ -1272,9 +1603,13 @@ func (m model) writeBottomSection(out *strings.Builder, hasEvents bool, botRows,
 		}
 	}
 
-	footerKeys := "[r] restart  [d] env down  [↑↓/PgUp/PgDn] scroll  [Home/End] jump  [q] quit"
+	footerKeys := "[r] restart  [d] env down  [t] txs  [e] events  [space] pause  [↑↓/PgUp/PgDn] scroll  [Home/End] jump  [q] quit"
 	if m.restarting {
 		footerKeys = "[f] frontend  [b] backend  [a] all  [q/esc] cancel"
+	} else if m.txFocus && (m.txDetail != nil || m.txDetailErr != "") {
+		footerKeys = "[esc] close details  [space] pause  [ctrl+c] quit"
+	} else if m.txFocus {
+		footerKeys = "[↑↓/jk] select  [enter] details  [t/esc] back  [space] pause  [q] quit"
 	} else if !m.isGraphQLEnabled() || !m.isFrontendEnabled() {
 		extras := ""
 		if !m.isGraphQLEnabled() {
@@ -1283,7 +1618,7 @@ func (m model) writeBottomSection(out *strings.Builder, hasEvents bool, botRows,
 		if !m.isFrontendEnabled() {
 			extras += "  [f] enable frontend"
 		}
-		footerKeys = "[r] restart  [d] env down" + extras + "  [↑↓/PgUp/PgDn] scroll  [Home/End] jump  [q] quit"
+		footerKeys = "[r] restart  [d] env down" + extras + "  [t] txs  [e] events  [space] pause  [↑↓/PgUp/PgDn] scroll  [Home/End] jump  [q] quit"
 	}
 	if hasEvents {
 		out.WriteString(buildBottomBorderWithJunction(m.width, leftInner, footerKeys))
@@ -1383,8 +1718,9 @@ func (m model) writeEnvConfig(b *bytes.Buffer, shorten func(string) string) {
 	leftInner, _, _ := m.panelWidths()
 
 	type item struct {
-		label string
-		value string
+		label  string
+		value  string
+		suffix string // pre-styled text appended after the value
 	}
 	items := []item{
 		{label: " Network:", value: network},
@@ -1400,13 +1736,17 @@ func (m model) writeEnvConfig(b *bytes.Buffer, shorten func(string) string) {
 		items = append(items, item{label: "GraphQL:", value: "http://" + resolveDisplayHost(m.host) + ":9125/graphql"})
 	}
 	if m.isFrontendEnabled() {
-		items = append(items, item{label: "Frontend:", value: "http://" + resolveDisplayHost(m.host) + ":5173"})
+		feHealth := lipgloss.NewStyle().Foreground(red).Render("DOWN")
+		if m.feHealthy {
+			feHealth = lipgloss.NewStyle().Foreground(green).Render("UP")
+		}
+		items = append(items, item{label: "Frontend:", value: "http://" + resolveDisplayHost(m.host) + ":5173", suffix: " " + feHealth})
 	}
 
 	var currentLine strings.Builder
 	currentWidth := 0
 	for i, it := range items {
-		rendered := labelStyle.Render(it.label) + " " + valueStyle.Render(it.value)
+		rendered := labelStyle.Render(it.label) + " " + valueStyle.Render(it.value) + it.suffix
 		renderedWidth := lipgloss.Width(rendered)
 
 		if currentWidth == 0 {
@@ -1422,7 +1762,7 @@ func (m model) writeEnvConfig(b *bytes.Buffer, shorten func(string) string) {
 				currentLine.Reset()
 				// Trim leading space if we wrap
 				trimmedLabel := strings.TrimSpace(it.label)
-				rendered = labelStyle.Render(" "+trimmedLabel) + " " + valueStyle.Render(it.value)
+				rendered = labelStyle.Render(" "+trimmedLabel) + " " + valueStyle.Render(it.value) + it.suffix
 				currentLine.WriteString(rendered)
 				currentWidth = lipgloss.Width(rendered)
 			}
@@ -1453,21 +1793,12 @@ func (m model) writeEnvObjects(b *bytes.Buffer, shorten func(string) string) {
 		return
 	}
 	b.WriteString(fmt.Sprintf("\n "+labelStyle.Render("Objects")+" %s\n", grayStyle.Render(fmt.Sprintf("(%d)", len(m.worldObjs)))))
-	knownKeys := []string{"governorCap", "adminAcl", "objectRegistry", "serverAddressRegistry", "energyConfig", "fuelConfig", "gateConfig"}
-	for _, key := range knownKeys {
-		if v, ok := m.worldObjs[key]; ok {
-			b.WriteString(fmt.Sprintf("  %-22s %s\n", labelStyle.Render(humanizeCamelCase(key)), grayStyle.Render(shorten(v))))
+	for _, key := range dashboard.OrderedObjectKeys(m.worldObjs) {
+		line := fmt.Sprintf("  %-22s %s", labelStyle.Render(humanizeCamelCase(key)), grayStyle.Render(shorten(m.worldObjs[key])))
+		if t := m.worldObjTypes[key]; t != "" {
+			line += " " + grayStyle.Render(shorten(t))
 		}
-	}
-	knownSet := make(map[string]bool, len(knownKeys))
-	for _, k := range knownKeys {
-		knownSet[k] = true
-	}
-	for k, v := range m.worldObjs {
-		if knownSet[k] {
-			continue
-		}
-		b.WriteString(fmt.Sprintf("  %-22s %s\n", labelStyle.Render(humanizeCamelCase(k)), grayStyle.Render(shorten(v))))
+		b.WriteString(line + "\n")
 	}
 }
 
@@ -1505,6 +1836,10 @@ func humanizeCamelCase(s string) string {
 }
 
 func (m model) renderRightContent(topRows int) string {
+	if m.txFocus && (m.txDetail != nil || m.txDetailErr != "" || m.txLoading) {
+		return m.renderTxDetail()
+	}
+
 	var b bytes.Buffer
 	b.WriteString("\n")
 	b.WriteString(fmt.Sprintf(" %s %s     %s %s\n",
@@ -1519,15 +1854,28 @@ func (m model) renderRightContent(topRows int) string {
 	// Recent transactions with column headers — adaptive to available rows
 	fixedLines := 3                        // blank + 2 stat lines
 	availForTx := topRows - fixedLines - 3 // 3 = blank + title + column header
-	if availForTx > 0 && len(m.recentTxs) > 0 {
-		b.WriteString("\n " + labelStyle.Render("Recent Transactions") + "\n")
+	if availForTx > 0 && (len(m.recentTxs) > 0 || m.hiddenTxs > 0) {
+		title := labelStyle.Render("Recent Transactions")
+		if m.hiddenTxs > 0 {
+			title += grayStyle.Render(fmt.Sprintf(" (%d older hidden)", m.hiddenTxs))
+		}
+		b.WriteString("\n " + title + "\n")
 		b.WriteString(grayStyle.Render("  ST  SENDER          TYPE        GAS       AGE") + "\n")
 		showCount := availForTx
 		if showCount > len(m.recentTxs) {
 			showCount = len(m.recentTxs)
 		}
-		for i := 0; i < showCount; i++ {
+		// Keep the cursor row visible when it is beyond the rows that fit.
+		start := 0
+		if m.txFocus && m.txCursor >= showCount {
+			start = m.txCursor - showCount + 1
+		}
+		for i := start; i < start+showCount && i < len(m.recentTxs); i++ {
 			tx := m.recentTxs[i]
+			cursor := " "
+			if m.txFocus && i == m.txCursor {
+				cursor = labelStyle.Render("▶")
+			}
 			statusIcon := grayStyle.Render(" ?")
 			if tx.Status == "success" {
 				statusIcon = lipgloss.NewStyle().Foreground(green).Render(" ✓")
@@ -1538,8 +1886,45 @@ func (m model) renderRightContent(topRows int) string {
 			kindStr := grayStyle.Render(fmt.Sprintf("%-10s", tx.Kind))
 			gasStr := grayStyle.Render(fmt.Sprintf("%9s", tx.GasUsed))
 			ageStr := grayStyle.Render(fmt.Sprintf("%5s", tx.Age))
-			b.WriteString(fmt.Sprintf("  %s %s  %s %s %s\n", statusIcon, senderStr, kindStr, gasStr, ageStr))
+			b.WriteString(fmt.Sprintf(" %s%s %s  %s %s %s\n", cursor, statusIcon, senderStr, kindStr, gasStr, ageStr))
+		}
+	}
+	return b.String()
+}
+
+// renderTxDetail renders the expanded transaction in place of the recent-tx list.
+func (m model) renderTxDetail() string {
+	var b bytes.Buffer
+	b.WriteString("\n")
+	if m.txLoading {
+		b.WriteString(" " + grayStyle.Render("Loading transaction...") + "\n")
+		return b.String()
+	}
+	if m.txDetailErr != "" {
+		b.WriteString(" " + lipgloss.NewStyle().Foreground(red).Render("Failed to load transaction: "+m.txDetailErr) + "\n")
+		return b.String()
+	}
+
+	d := m.txDetail
+	b.WriteString(" " + labelStyle.Render("Transaction "+shortDigest(d.Digest)) + "\n")
+	row := func(label, value string) {
+		if value == "" {
+			value = "-"
 		}
+		b.WriteString(fmt.Sprintf(" %s %s\n", labelStyle.Render(fmt.Sprintf("%-11s", label)), valueStyle.Render(value)))
+	}
+	row("Digest:", d.Digest)
+	row("Status:", d.Status)
+	if d.Error != "" {
+		row("Error:", d.Error)
+	}
+	row("Sender:", d.Sender)
+	row("Checkpoint:", d.Checkpoint)
+	row("Gas:", d.GasUsed)
+	row("Objects:", fmt.Sprintf("%d created, %d mutated, %d deleted", d.Created, d.Mutated, d.Deleted))
+	b.WriteString(fmt.Sprintf(" %s\n", labelStyle.Render(fmt.Sprintf("Events (%d)", len(d.Events)))))
+	for _, ev := range d.Events {
+		b.WriteString("   " + grayStyle.Render(ev) + "\n")
 	}
 	return b.String()
 }
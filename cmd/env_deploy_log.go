@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"os/signal"
+	"time"
+
+	"efctl/pkg/status"
+	"efctl/pkg/ui"
+
+	"github.com/spf13/cobra"
+)
+
+var deployLogFollow bool
+
+// deployLogPollInterval is how often --follow checks deploy.log for new output.
+var deployLogPollInterval = 500 * time.Millisecond
+
+var envDeployLogCmd = &cobra.Command{
+	Use:   "deploy-log",
+	Short: "Print the world deployment log",
+	Long: `Prints world-contracts/deployments/localnet/deploy.log, the output of the world
+deployment run by 'env up'. With --follow, keeps printing new lines as they are
+written until interrupted.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		logPath := status.DeployLogPath(workspacePath)
+		file, err := os.Open(logPath) // #nosec G304 -- path is workspace-relative by design
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				ui.Error.Println("No deploy log found at " + logPath + ". Run 'efctl env up' first.")
+			} else {
+				ui.Error.Println("Failed to open deploy log: " + err.Error())
+			}
+			os.Exit(1)
+		}
+		defer file.Close()
+
+		if !deployLogFollow {
+			if _, err := io.Copy(os.Stdout, file); err != nil {
+				ui.Error.Println("Failed to read deploy log: " + err.Error())
+				os.Exit(1)
+			}
+			return
+		}
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer stop()
+		if err := followFile(ctx, file, os.Stdout, deployLogPollInterval); err != nil {
+			ui.Error.Println("Failed to read deploy log: " + err.Error())
+			os.Exit(1)
+		}
+	},
+}
+
+// followFile copies r to w and keeps polling for appended data every
+// interval until ctx is done.
+func followFile(ctx context.Context, r io.Reader, w io.Writer, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := io.Copy(w, r); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func init() {
+	envDeployLogCmd.Flags().BoolVarP(&deployLogFollow, "follow", "f", false, "Keep printing new lines as the deployment writes them")
+	envCmd.AddCommand(envDeployLogCmd)
+}
@@ -1,16 +1,39 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"os"
 
 	"efctl/pkg/container"
 	"efctl/pkg/setup"
 	"efctl/pkg/sui"
 	"efctl/pkg/ui"
+	"github.com/jedib0t/go-pretty/v6/table"
 	"github.com/spf13/cobra"
 )
 
+var keepNodeModules bool
+var downDryRun bool
+var downContainersOnly bool
+var downImagesOnly bool
+var downVolumesOnly bool
+
+// downScope maps the --*-only flags to a cleanup scope.
+func downScope() container.CleanupScope {
+	switch {
+	case downContainersOnly:
+		return container.CleanupContainers
+	case downImagesOnly:
+		return container.CleanupImages
+	case downVolumesOnly:
+		return container.CleanupVolumes
+	default:
+		return container.CleanupAll
+	}
+}
+
 var envDownCmd = &cobra.Command{
 	Use:   "down",
 	Short: "Tear down the local environment",
@@ -24,11 +47,25 @@ var envDownCmd = &cobra.Command{
 			ui.Error.Println("Failed to create container client: " + err.Error())
 			os.Exit(1)
 		}
-		if cleanErr := setup.CleanEnvironment(c); cleanErr != nil {
+		opts := container.CleanupOptions{KeepFrontendModules: keepNodeModules, Scope: downScope()}
+		if downDryRun {
+			renderCleanupPlan(os.Stdout, c.CleanupPlan(context.Background(), opts))
+			return
+		}
+		if cleanErr := setup.CleanEnvironment(c, opts); cleanErr != nil {
 			ui.Error.Println("Cleanup failed: " + cleanErr.Error())
 			os.Exit(1)
 		}
 
+		if opts.Scope != container.CleanupAll && opts.Scope != container.CleanupContainers {
+			ui.Success.Println(fmt.Sprintf("%s Selected resources cleaned up; containers were kept.", ui.CleanEmoji))
+			return
+		}
+
+		if err := setup.ClearUpState(workspacePath); err != nil {
+			ui.Warn.Println("Could not clear env up progress marker: " + err.Error())
+		}
+
 		// Also teardown Sui client configuration
 		if err := sui.TeardownSui(); err != nil {
 			ui.Warn.Println("Sui client teardown failed: " + err.Error())
@@ -38,6 +75,32 @@ var envDownCmd = &cobra.Command{
 	},
 }
 
+// renderCleanupPlan prints each resource env down would remove and whether it
+// currently exists.
+func renderCleanupPlan(w io.Writer, plan []container.CleanupTarget) {
+	t := table.NewWriter()
+	t.SetOutputMirror(w)
+	t.SetStyle(table.StyleRounded)
+	t.AppendHeader(table.Row{"Kind", "Name", "Exists"})
+	existing := 0
+	for _, target := range plan {
+		exists := "no"
+		if target.Exists {
+			exists = "yes"
+			existing++
+		}
+		t.AppendRow(table.Row{target.Kind, target.Name, exists})
+	}
+	t.Render()
+	_, _ = fmt.Fprintf(w, "Dry run: %d of %d resources exist and would be removed. Nothing was removed.\n", existing, len(plan))
+}
+
 func init() {
+	envDownCmd.Flags().BoolVar(&keepNodeModules, "keep-node-modules", false, "Keep the frontend node_modules volume so the next env up skips a full pnpm install")
+	envDownCmd.Flags().BoolVar(&downDryRun, "dry-run", false, "List the containers, images, volumes, and network env down would remove and whether each exists, without removing anything")
+	envDownCmd.Flags().BoolVar(&downContainersOnly, "containers-only", false, "Stop and remove the containers and network, keeping images and volumes")
+	envDownCmd.Flags().BoolVar(&downImagesOnly, "images-only", false, "Remove only the built images, for example to reclaim disk space")
+	envDownCmd.Flags().BoolVar(&downVolumesOnly, "volumes-only", false, "Remove only the config, database, and node_modules volumes (volumes still used by a container are kept)")
+	envDownCmd.MarkFlagsMutuallyExclusive("containers-only", "images-only", "volumes-only")
 	envCmd.AddCommand(envDownCmd)
 }
@@ -0,0 +1,291 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"efctl/pkg/config"
+	"efctl/pkg/container"
+	"efctl/pkg/secrets"
+	"efctl/pkg/setup"
+	"efctl/pkg/sui"
+	"efctl/pkg/ui"
+	"efctl/pkg/validate"
+
+	"github.com/spf13/cobra"
+)
+
+var envEnvCmd = &cobra.Command{
+	Use:   "env",
+	Short: "View and edit world-contracts/.env",
+	Long: `Reads and updates world-contracts/.env in the workspace. 'set' keeps comments
+and the order of existing lines, and checks keys and values before writing. The
+file is owned by the sui-playground container, so 'set' writes it through the
+container and needs the environment to be running.`,
+}
+
+var envEnvListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the keys in world-contracts/.env",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		envMap := readWorldEnvOrExit()
+		keys := make([]string, 0, len(envMap))
+		for k := range envMap {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			fmt.Printf("%s=%s\n", k, ui.Redact(envMap[k]))
+		}
+	},
+}
+
+var envEnvGetCmd = &cobra.Command{
+	Use:   "get KEY",
+	Short: "Print the value of a key in world-contracts/.env",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		key := args[0]
+		if err := validate.EnvKey(key); err != nil {
+			ui.Error.Println(err.Error())
+			os.Exit(1)
+		}
+		val, ok := readWorldEnvOrExit()[key]
+		if !ok {
+			ui.Error.Printfln("%s is not set in world-contracts/.env", key)
+			os.Exit(1)
+		}
+		fmt.Println(val)
+	},
+}
+
+var envEnvSetCmd = &cobra.Command{
+	Use:     "set KEY=VALUE",
+	Short:   "Set a key in world-contracts/.env",
+	Example: `  efctl env env set SPONSOR_ADDRESSES=0xabc...,0xdef...`,
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		key, val, err := parseEnvAssignment(args[0])
+		if err != nil {
+			ui.Error.Println(err.Error())
+			os.Exit(1)
+		}
+		envPath := worldEnvPath()
+		if _, err := os.Stat(envPath); err != nil {
+			ui.Error.Println("No world-contracts/.env found in the workspace. Run 'efctl env up' first.")
+			os.Exit(1)
+		}
+		c, err := container.NewClient()
+		if err != nil {
+			ui.Error.Println("Failed to initialize container client: " + err.Error())
+			os.Exit(1)
+		}
+		if !c.ContainerRunning(container.ContainerSuiPlayground) {
+			ui.Error.Println("The environment is not running. Start it with `efctl env up`.")
+			os.Exit(1)
+		}
+		if err := setup.UpdateWorldEnv(context.Background(), c, map[string]string{key: val}); err != nil {
+			ui.Error.Printfln("Failed to update %s: %v", envPath, err)
+			os.Exit(1)
+		}
+		ui.Success.Printfln("Set %s in %s", key, envPath)
+	},
+}
+
+var envEnvValidateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Check world-contracts/.env and builder-scaffold/.env for malformed values",
+	Long: `Checks world-contracts/.env against the keys efctl knows: addresses must be Sui
+addresses, private keys must be suiprivkey1... strings, and URLs must be http(s).
+The keys listed in the world-env-required config field must be set. Private keys
+moved to the OS keyring with 'env secrets import' count as set.
+builder-scaffold/.env is checked too when it exists.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		envMap := readWorldEnvOrExit()
+		secrets.Overlay(secrets.Default, worldEnvPath(), envMap)
+		problems := validateEnvMap(envMap, config.Loaded.GetWorldEnvRequired())
+		reportEnvProblems("world-contracts/.env", problems)
+
+		scaffoldEnv := filepath.Join(workspacePath, "builder-scaffold", ".env")
+		if scaffoldMap, err := setup.ParseDotEnv(scaffoldEnv); err == nil {
+			scaffoldProblems := validateEnvMap(scaffoldMap, nil)
+			reportEnvProblems("builder-scaffold/.env", scaffoldProblems)
+			problems = append(problems, scaffoldProblems...)
+		}
+
+		if len(problems) > 0 {
+			os.Exit(1)
+		}
+	},
+}
+
+func reportEnvProblems(name string, problems []error) {
+	if len(problems) == 0 {
+		ui.Success.Printfln("%s is valid", name)
+		return
+	}
+	ui.Error.Printfln("%s has %d problem(s):", name, len(problems))
+	for _, p := range problems {
+		fmt.Println("  - " + ui.Redact(p.Error()))
+	}
+}
+
+func worldEnvPath() string {
+	return filepath.Join(workspacePath, "world-contracts", ".env")
+}
+
+func readWorldEnvOrExit() map[string]string {
+	envMap, err := setup.ParseDotEnv(worldEnvPath())
+	if err != nil {
+		ui.Error.Println("Failed to read world-contracts/.env: " + err.Error())
+		os.Exit(1)
+	}
+	return envMap
+}
+
+// parseEnvAssignment splits KEY=VALUE and checks both halves.
+func parseEnvAssignment(arg string) (string, string, error) {
+	key, val, ok := strings.Cut(arg, "=")
+	if !ok {
+		return "", "", fmt.Errorf("expected KEY=VALUE, got %q", arg)
+	}
+	key = strings.TrimSpace(key)
+	val = strings.TrimSpace(val)
+	if err := validate.EnvKey(key); err != nil {
+		return "", "", err
+	}
+	if err := validateEnvValue(key, val); err != nil {
+		return "", "", err
+	}
+	return key, val, nil
+}
+
+// envKind is the expected format of a .env value.
+type envKind int
+
+const (
+	envAny envKind = iota
+	envAddress
+	envAddressList
+	envPrivateKey
+	envURL
+)
+
+// envSchema lists the world-contracts and builder-scaffold keys efctl knows
+// the format of. Keys not listed fall back to envSuffixKinds.
+var envSchema = map[string]envKind{
+	"ADMIN_ADDRESS":        envAddress,
+	"ADMIN_PRIVATE_KEY":    envPrivateKey,
+	"PLAYER_A_ADDRESS":     envAddress,
+	"PLAYER_A_PRIVATE_KEY": envPrivateKey,
+	"PLAYER_B_ADDRESS":     envAddress,
+	"PLAYER_B_PRIVATE_KEY": envPrivateKey,
+	"SPONSOR_ADDRESS":      envAddress,
+	"SPONSOR_ADDRESSES":    envAddressList,
+	"WORLD_PACKAGE_ID":     envAddress,
+	"BUILDER_PACKAGE_ID":   envAddress,
+	"EXTENSION_CONFIG_ID":  envAddress,
+}
+
+// envSuffixKinds infers the format of unlisted keys from their name.
+var envSuffixKinds = []struct {
+	suffix string
+	kind   envKind
+}{
+	{"_ADDRESSES", envAddressList},
+	{"_ADDRESS", envAddress},
+	{"_PRIVATE_KEY", envPrivateKey},
+	{"_URL", envURL},
+}
+
+func envKindOf(key string) envKind {
+	if kind, ok := envSchema[key]; ok {
+		return kind
+	}
+	for _, s := range envSuffixKinds {
+		if strings.HasSuffix(key, s.suffix) {
+			return s.kind
+		}
+	}
+	return envAny
+}
+
+// validateEnvValue checks a value against the format its key implies. Empty
+// values pass; values may never span lines.
+func validateEnvValue(key, val string) error {
+	if strings.ContainsAny(val, "\r\n") {
+		return fmt.Errorf("value for %s must not contain newlines", key)
+	}
+	if val == "" {
+		return nil
+	}
+
+	var err error
+	switch envKindOf(key) {
+	case envAddress:
+		err = validate.SuiAddress(val)
+	case envAddressList:
+		for _, addr := range strings.Split(val, ",") {
+			if err = validate.SuiAddress(strings.TrimSpace(addr)); err != nil {
+				break
+			}
+		}
+	case envPrivateKey:
+		err = validatePrivateKey(val)
+	case envURL:
+		err = validate.URL(val)
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %w", key, err)
+	}
+	return nil
+}
+
+// validatePrivateKey checks that val is a suiprivkey1... string and that it
+// decodes: the checksum, length, and key scheme must be valid.
+func validatePrivateKey(val string) error {
+	if err := validate.SuiPrivateKey(val); err != nil {
+		return err
+	}
+	if _, _, err := sui.DecodePrivKey(val); err != nil {
+		return fmt.Errorf("invalid Sui private key: %w", err)
+	}
+	return nil
+}
+
+// validateEnvMap checks every value in env against the schema and that each
+// required key is set. Problems are returned sorted by key.
+func validateEnvMap(env map[string]string, required []string) []error {
+	keys := make([]string, 0, len(env))
+	for k := range env {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var problems []error
+	for _, k := range required {
+		if strings.Trim(env[k], `"'`) == "" {
+			problems = append(problems, fmt.Errorf("%s is required but not set", k))
+		}
+	}
+	for _, k := range keys {
+		if err := validateEnvValue(k, strings.Trim(env[k], `"'`)); err != nil {
+			problems = append(problems, err)
+		}
+	}
+	return problems
+}
+
+func init() {
+	envEnvCmd.AddCommand(envEnvListCmd)
+	envEnvCmd.AddCommand(envEnvGetCmd)
+	envEnvCmd.AddCommand(envEnvSetCmd)
+	envEnvCmd.AddCommand(envEnvValidateCmd)
+	envCmd.AddCommand(envEnvCmd)
+}
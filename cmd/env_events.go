@@ -0,0 +1,180 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"time"
+
+	"efctl/pkg/env"
+	"efctl/pkg/httpclient"
+	"efctl/pkg/status"
+	"efctl/pkg/ui"
+
+	"github.com/spf13/cobra"
+)
+
+// maxEventLineSize bounds one line of an existing --out file when reading back
+// the events it already holds; parsedJson can be large.
+const maxEventLineSize = 1 << 20
+
+var (
+	envEventsOut      string
+	envEventsFollow   bool
+	envEventsInterval time.Duration
+	envEventsLimit    int
+	envEventsRPCURL   string
+)
+
+var envEventsCmd = &cobra.Command{
+	Use:   "events",
+	Short: "Export world events as JSON lines",
+	Long: `Queries the events the world admin sent from the world package (the same events
+the dashboard shows) and writes each as one JSON object per line, oldest first.
+
+With --out the events are appended to a file. Events already in the file are
+skipped, identified by transaction digest and event sequence, so the command
+can be re-run or resumed against the same file. With --follow it keeps polling
+every --interval until interrupted. Each poll fetches the newest --limit events;
+raise --limit or lower --interval if more events than that arrive between polls.`,
+	Example: `  efctl env events --follow --out events.jsonl`,
+	Args:    cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		if envEventsLimit < 1 || envEventsLimit > maxDashFetchLimit {
+			ui.Error.Printfln("--limit must be between 1 and %d, got %d", maxDashFetchLimit, envEventsLimit)
+			os.Exit(1)
+		}
+		if envEventsFollow && envEventsInterval <= 0 {
+			ui.Error.Printfln("--interval must be positive, got %s", envEventsInterval)
+			os.Exit(1)
+		}
+
+		pkgID, admin := status.WorldEventFilter(workspacePath)
+		if pkgID == "" || admin == "" {
+			ui.Error.Println("No deployed world found in the workspace. Run 'efctl env up' first.")
+			os.Exit(1)
+		}
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer stop()
+
+		if !cmd.Flags().Changed("rpc-url") {
+			engine, _ := env.CheckPrerequisites().Engine()
+			envEventsRPCURL = status.DetectRPCURL(ctx, engine)
+		}
+
+		var out io.Writer = os.Stdout
+		seen := make(map[string]bool)
+		if envEventsOut != "" {
+			var err error
+			if seen, err = readEventIDs(envEventsOut); err != nil {
+				ui.Error.Printfln("Failed to read %s: %v", envEventsOut, err)
+				os.Exit(1)
+			}
+			f, err := os.OpenFile(filepath.Clean(envEventsOut), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600) // #nosec G304 -- path is the user's own --out flag
+			if err != nil {
+				ui.Error.Printfln("Failed to open %s: %v", envEventsOut, err)
+				os.Exit(1)
+			}
+			defer f.Close()
+			out = f
+		}
+
+		client := httpclient.NewEndpoint(status.RPCTimeout)
+		for {
+			events, err := status.QueryWorldEvents(ctx, client, envEventsRPCURL, pkgID, admin, envEventsLimit)
+			switch {
+			case ctx.Err() != nil:
+				return
+			case err != nil && !envEventsFollow:
+				ui.Error.Printfln("Failed to query events from %s: %v", envEventsRPCURL, err)
+				os.Exit(1)
+			case err != nil:
+				ui.Debug.Printfln("Event poll failed: %v", err)
+			default:
+				n, err := writeNewEvents(out, events, seen)
+				if err != nil {
+					ui.Error.Printfln("Failed to write events: %v", err)
+					os.Exit(1)
+				}
+				if envEventsOut != "" && n > 0 {
+					ui.Info.Printfln("Wrote %d new event(s) to %s", n, envEventsOut)
+				}
+			}
+
+			if !envEventsFollow {
+				return
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(envEventsInterval):
+			}
+		}
+	},
+}
+
+// writeNewEvents writes the events not yet in seen to w as JSON lines, oldest
+// first, marks them seen, and returns how many it wrote. events is newest
+// first, as QueryWorldEvents returns them.
+func writeNewEvents(w io.Writer, events []status.WorldEvent, seen map[string]bool) (int, error) {
+	n := 0
+	for i := len(events) - 1; i >= 0; i-- {
+		ev := events[i]
+		if seen[ev.ID()] {
+			continue
+		}
+		data, err := json.Marshal(ev)
+		if err != nil {
+			return n, err
+		}
+		if _, err := w.Write(append(data, '\n')); err != nil {
+			return n, err
+		}
+		seen[ev.ID()] = true
+		n++
+	}
+	return n, nil
+}
+
+// readEventIDs returns the IDs of the events already exported to path. A
+// missing file holds no events; lines that are not events are ignored.
+func readEventIDs(path string) (map[string]bool, error) {
+	seen := make(map[string]bool)
+	f, err := os.Open(filepath.Clean(path)) // #nosec G304 -- path is the user's own --out flag
+	if errors.Is(err, os.ErrNotExist) {
+		return seen, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxEventLineSize)
+	for scanner.Scan() {
+		var ev status.WorldEvent
+		if json.Unmarshal(scanner.Bytes(), &ev) == nil && ev.TxDigest != "" {
+			seen[ev.ID()] = true
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return seen, nil
+}
+
+func init() {
+	envEventsCmd.Flags().StringVarP(&envEventsOut, "out", "o", "", "Append events to this file instead of printing them")
+	envEventsCmd.Flags().BoolVarP(&envEventsFollow, "follow", "f", false, "Keep polling for new events until interrupted")
+	envEventsCmd.Flags().DurationVar(&envEventsInterval, "interval", defaultDashRefresh, "Time between polls with --follow (e.g. 5s)")
+	envEventsCmd.Flags().IntVar(&envEventsLimit, "limit", maxDashFetchLimit, fmt.Sprintf("Number of recent events to fetch per poll (1-%d)", maxDashFetchLimit))
+	envEventsCmd.Flags().StringVar(&envEventsRPCURL, "rpc-url", status.DefaultRPCURL, "Sui JSON-RPC endpoint URL (detected from the running container when not set)")
+	envCmd.AddCommand(envEventsCmd)
+}
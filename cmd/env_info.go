@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"efctl/pkg/config"
+	"efctl/pkg/doctor"
+	"efctl/pkg/env"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+)
+
+var envInfoCmd = &cobra.Command{
+	Use:   "info",
+	Short: "Show tool versions, resolved configuration and workspace metadata",
+	Long: `Prints the efctl, container engine, node, git and sui versions together with the
+resolved configuration and the commit of each cloned repository. The output is
+intended to be pasted into bug reports.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		info := doctor.GatherInfo(doctor.Options{
+			Workspace:    workspacePath,
+			Version:      Version,
+			CommitSHA:    CommitSHA,
+			BuildDate:    BuildDate,
+			Prereqs:      env.CheckPrerequisites(),
+			ConfigLoaded: config.Loaded.WasLoaded(),
+			ConfigPath:   configFile,
+			Config:       config.Loaded,
+		})
+
+		renderInfoTable(info)
+	},
+}
+
+func renderInfoTable(info *doctor.Info) {
+	t := table.NewWriter()
+	t.SetOutputMirror(os.Stdout)
+	t.SetStyle(table.StyleRounded)
+	t.AppendHeader(table.Row{"Property", "Value"})
+
+	t.AppendRow(table.Row{"efctl", fmt.Sprintf("%s (%s) built %s %s/%s",
+		info.Efctl.Version, info.Efctl.CommitSHA, info.Efctl.BuildDate, info.Efctl.GOOS, info.Efctl.GOARCH)})
+	t.AppendRow(table.Row{"workspace", info.Workspace})
+	t.AppendSeparator()
+
+	engine := "not found"
+	if info.Container.Found {
+		engine = fmt.Sprintf("%s %s", info.Container.Engine, info.Container.Version)
+	}
+	t.AppendRow(table.Row{"container engine", engine})
+	t.AppendRow(table.Row{"node", toolLabel(info.Node.Found, info.Node.Version)})
+	t.AppendRow(table.Row{"git", toolLabel(info.Git.Found, info.Git.Version)})
+	t.AppendRow(table.Row{"sui", toolLabel(info.Sui.Found, info.Sui.Version)})
+	t.AppendSeparator()
+
+	for _, repo := range info.Repos {
+		t.AppendRow(table.Row{repo.Name, repoLabel(repo)})
+	}
+	t.AppendSeparator()
+
+	cfgLabel := "not found (using defaults)"
+	if info.Config.Loaded {
+		cfgLabel = info.Config.FilePath
+	}
+	t.AppendRow(table.Row{"config file", cfgLabel})
+	for _, entry := range info.Resolved {
+		t.AppendRow(table.Row{entry.Key, entry.Value})
+	}
+
+	t.Render()
+}
+
+func toolLabel(found bool, version string) string {
+	if !found {
+		return "not found"
+	}
+	if version == "" {
+		return "unknown version"
+	}
+	return version
+}
+
+func init() {
+	envCmd.AddCommand(envInfoCmd)
+}
@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"efctl/pkg/browser"
+	"efctl/pkg/config"
+	"efctl/pkg/setup"
+	"efctl/pkg/ui"
+
+	"github.com/spf13/cobra"
+)
+
+var envOpenTargets = []string{"explorer", "frontend", "graphql"}
+
+var envOpenCmd = &cobra.Command{
+	Use:       "open [explorer|frontend|graphql]",
+	Short:     "Open the explorer, frontend dApp, or GraphQL endpoint in a browser",
+	Long:      `Opens a local environment URL in the default browser. With no argument the explorer for the local network is opened (Suiscan unless explorer-url-template is set). When no browser can be launched (for example over SSH) the URL is printed instead.`,
+	Args:      cobra.MatchAll(cobra.MaximumNArgs(1), cobra.OnlyValidArgs),
+	ValidArgs: envOpenTargets,
+	Run: func(cmd *cobra.Command, args []string) {
+		target := "explorer"
+		if len(args) == 1 {
+			target = args[0]
+		}
+
+		url, err := envOpenURL(target, config.Loaded.GetHost())
+		if err != nil {
+			ui.Error.Println(err.Error())
+			os.Exit(1)
+		}
+
+		if err := browser.Open(url); err != nil {
+			if !errors.Is(err, browser.ErrHeadless) {
+				ui.Warn.Println("Failed to open browser: " + err.Error())
+			}
+			fmt.Println(url)
+			return
+		}
+		ui.Success.Println("Opened " + url)
+	},
+}
+
+// envOpenURL returns the URL for an env open target using the configured bind host.
+func envOpenURL(target, host string) (string, error) {
+	base := "http://" + resolveDisplayHost(host)
+	switch target {
+	case "explorer":
+		return setup.ExplorerURL(base + ":9000"), nil
+	case "frontend":
+		return base + ":5173", nil
+	case "graphql":
+		return base + ":9125/graphql", nil
+	default:
+		return "", fmt.Errorf("unknown target %q: must be one of explorer, frontend, graphql", target)
+	}
+}
+
+func init() {
+	envCmd.AddCommand(envOpenCmd)
+}
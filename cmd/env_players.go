@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"strconv"
+
+	"efctl/pkg/container"
+	"efctl/pkg/setup"
+	"efctl/pkg/ui"
+
+	"github.com/spf13/cobra"
+)
+
+var playersFaucetURL string
+
+var envPlayersCmd = &cobra.Command{
+	Use:   "players",
+	Short: "Manage extra test player accounts",
+}
+
+var envPlayersAddCmd = &cobra.Command{
+	Use:   "add [N]",
+	Short: "Generate and fund N extra player accounts (default 1)",
+	Long: `Generates N new Ed25519 accounts and records them in world-contracts/.env as
+PLAYER_C_ADDRESS / PLAYER_C_PRIVATE_KEY, PLAYER_D_..., continuing after the last
+player already there. The keys are imported into the sui keystore under
+ef-player-c, ef-player-d, ... when sui is installed, and each account is funded
+from the local faucet.`,
+	Example: `  efctl env players add 3`,
+	Args:    cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		n := 1
+		if len(args) == 1 {
+			var err error
+			if n, err = strconv.Atoi(args[0]); err != nil || n < 1 {
+				ui.Error.Printfln("invalid number of players %q: must be a positive integer", args[0])
+				os.Exit(1)
+			}
+		}
+
+		c, err := container.NewClient()
+		if err != nil {
+			ui.Error.Println("Failed to initialize container client: " + err.Error())
+			os.Exit(1)
+		}
+		if !c.ContainerRunning(container.ContainerSuiPlayground) {
+			ui.Error.Println("The environment is not running. Start it with `efctl env up`.")
+			os.Exit(1)
+		}
+
+		players, err := setup.AddPlayers(context.Background(), c, workspacePath, playersFaucetURL, n)
+		if err != nil {
+			ui.Error.Println("Failed to add players: " + err.Error())
+			os.Exit(1)
+		}
+		for _, p := range players {
+			if p.Funded {
+				ui.Success.Printfln("%s (%s): %s", p.Role, p.Alias, p.Address)
+			} else {
+				ui.Warn.Printfln("%s (%s): %s (not funded, retry with 'efctl env faucet -a %s')", p.Role, p.Alias, p.Address, p.Address)
+			}
+		}
+	},
+}
+
+func init() {
+	envPlayersAddCmd.Flags().StringVar(&playersFaucetURL, "faucet-url", "http://localhost:9123", "The URL of the faucet")
+	envPlayersCmd.AddCommand(envPlayersAddCmd)
+	envCmd.AddCommand(envPlayersCmd)
+}
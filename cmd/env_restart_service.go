@@ -0,0 +1,39 @@
+package cmd
+
+import (
+	"os"
+	"strings"
+
+	"efctl/pkg/container"
+	"efctl/pkg/setup"
+	"efctl/pkg/ui"
+
+	"github.com/spf13/cobra"
+)
+
+var envRestartServiceCmd = &cobra.Command{
+	Use:   "restart-service [" + strings.Join(setup.RestartableServices, "|") + "]",
+	Short: "Restart a single service container",
+	Long: `Restarts one container without tearing down the whole environment, then checks
+that it is still running. Useful when the frontend dev server wedges after a
+dependency install.`,
+	Args:      cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+	ValidArgs: setup.RestartableServices,
+	Run: func(cmd *cobra.Command, args []string) {
+		c, err := container.NewClient()
+		if err != nil {
+			ui.Error.Println("Failed to initialize container client: " + err.Error())
+			os.Exit(1)
+		}
+
+		if err := setup.RestartService(c, args[0]); err != nil {
+			ui.Error.Println("Restart failed: " + err.Error())
+			os.Exit(1)
+		}
+		ui.Success.Println("Service " + args[0] + " restarted.")
+	},
+}
+
+func init() {
+	envCmd.AddCommand(envRestartServiceCmd)
+}
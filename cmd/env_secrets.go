@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"efctl/pkg/secrets"
+	"efctl/pkg/ui"
+
+	"github.com/spf13/cobra"
+)
+
+var envSecretsImportYes bool
+
+var envSecretsCmd = &cobra.Command{
+	Use:   "secrets",
+	Short: "Manage workspace private keys stored in the OS keyring",
+}
+
+var envSecretsImportCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Move private keys from world-contracts/.env into the OS keyring",
+	Long: `Stores every <ROLE>_PRIVATE_KEY value from world-contracts/.env in the OS keyring
+(macOS Keychain, Secret Service on Linux, or Windows Credential Manager) and blanks
+it in the .env file. efctl reads blanked keys back from the keyring when
+configuring the sui client, deriving addresses, and initialising extensions.
+
+Note that 'env extension init' still writes the keys into builder-scaffold/.env
+because the scaffold scripts read them from there.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		envPath := filepath.Join(workspacePath, "world-contracts", ".env")
+		if _, err := os.Stat(envPath); err != nil {
+			ui.Error.Println("No world-contracts/.env found in the workspace. Run 'efctl env up' first.")
+			os.Exit(1)
+		}
+
+		if !envSecretsImportYes && !ui.Confirm("Move private keys from "+envPath+" into the OS keyring?") {
+			ui.Warn.Println("Import cancelled.")
+			return
+		}
+
+		imported, err := secrets.Import(secrets.Default, envPath)
+		if err != nil {
+			ui.Error.Println("Secrets import failed: " + err.Error())
+			os.Exit(1)
+		}
+		if len(imported) == 0 {
+			ui.Info.Println("No plaintext private keys found in " + envPath + ".")
+			return
+		}
+
+		ui.Success.Println("Moved " + strings.Join(imported, ", ") + " into the OS keyring.")
+	},
+}
+
+func init() {
+	envSecretsImportCmd.Flags().BoolVarP(&envSecretsImportYes, "yes", "y", false, "Skip the confirmation prompt")
+	envSecretsCmd.AddCommand(envSecretsImportCmd)
+	envCmd.AddCommand(envSecretsCmd)
+}
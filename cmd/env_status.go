@@ -1,9 +1,16 @@
 package cmd
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"os"
+	"os/signal"
 	"sort"
+	"strings"
+	"text/template"
+	"time"
 
 	"efctl/pkg/env"
 	"efctl/pkg/status"
@@ -13,13 +20,42 @@ import (
 	"github.com/spf13/cobra"
 )
 
-var envStatusRPCURL string
+var (
+	envStatusRPCURL     string
+	envStatusFormat     string
+	envStatusRPCTimeout time.Duration
+)
+
+// worldExport is the machine-readable form of the world section of env status.
+type worldExport struct {
+	PackageID string            `json:"packageId"`
+	Objects   map[string]string `json:"objects"`
+	Addresses map[string]string `json:"addresses"`
+}
 
 var envStatusCmd = &cobra.Command{
 	Use:   "status",
 	Short: "Show environment status without launching the dashboard",
 	Long:  `Shows container status, port usage, chain health, and deployed world metadata in a lightweight non-interactive output.`,
 	Run: func(cmd *cobra.Command, args []string) {
+		var containerTmpl *template.Template
+		switch envStatusFormat {
+		case "table", "json", "csv":
+		default:
+			tmpl, err := parseContainerFormat(envStatusFormat)
+			if err != nil {
+				ui.Error.Println(fmt.Sprintf("Invalid --format %q: must be one of table, json, csv, tsv, or a Go template: %v", envStatusFormat, err))
+				os.Exit(1)
+			}
+			containerTmpl = tmpl
+		}
+
+		if envStatusRPCTimeout <= 0 {
+			ui.Error.Println(fmt.Sprintf("--rpc-poll-timeout must be positive, got %s", envStatusRPCTimeout))
+			os.Exit(1)
+		}
+		status.RPCTimeout = envStatusRPCTimeout
+
 		res := env.CheckPrerequisites()
 		engine, err := res.Engine()
 		if err != nil {
@@ -27,7 +63,30 @@ var envStatusCmd = &cobra.Command{
 			engine = ""
 		}
 
-		st := status.Gather(engine, workspacePath, envStatusRPCURL)
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer stop()
+
+		if !cmd.Flags().Changed("rpc-url") {
+			envStatusRPCURL = status.DetectRPCURL(ctx, engine)
+		}
+
+		st := status.Gather(ctx, engine, workspacePath, envStatusRPCURL)
+
+		if containerTmpl != nil {
+			if err := renderContainerExport(os.Stdout, st.Containers, containerTmpl); err != nil {
+				ui.Error.Println("Failed to render containers: " + err.Error())
+				os.Exit(1)
+			}
+			return
+		}
+
+		if envStatusFormat != "table" {
+			if err := renderWorldExport(os.Stdout, st.World, envStatusFormat); err != nil {
+				ui.Error.Println("Failed to render world objects: " + err.Error())
+				os.Exit(1)
+			}
+			return
+		}
 
 		renderContainerTable(st.Containers)
 		renderPortTable(st.Ports)
@@ -114,15 +173,10 @@ func renderWorldTable(world status.WorldInfo) {
 	tObjects := table.NewWriter()
 	tObjects.SetOutputMirror(os.Stdout)
 	tObjects.SetStyle(table.StyleRounded)
-	tObjects.AppendHeader(table.Row{"Object", "ID"})
+	tObjects.AppendHeader(table.Row{"Object", "ID", "Type"})
 
-	objectKeys := make([]string, 0, len(world.Objects))
-	for key := range world.Objects {
-		objectKeys = append(objectKeys, key)
-	}
-	sort.Strings(objectKeys)
-	for _, key := range objectKeys {
-		tObjects.AppendRow(table.Row{key, world.Objects[key]})
+	for _, key := range sortedKeys(world.Objects) {
+		tObjects.AppendRow(table.Row{key, world.Objects[key], world.ObjectTypes[key]})
 	}
 
 	ui.Info.Println("World Objects")
@@ -138,12 +192,7 @@ func renderWorldTable(world status.WorldInfo) {
 	tAddr.SetStyle(table.StyleRounded)
 	tAddr.AppendHeader(table.Row{"Address Key", "Value"})
 
-	addrKeys := make([]string, 0, len(world.Addresses))
-	for key := range world.Addresses {
-		addrKeys = append(addrKeys, key)
-	}
-	sort.Strings(addrKeys)
-	for _, key := range addrKeys {
+	for _, key := range sortedKeys(world.Addresses) {
 		tAddr.AppendRow(table.Row{key, world.Addresses[key]})
 	}
 
@@ -155,6 +204,10 @@ func renderWorldTable(world status.WorldInfo) {
 	}
 	fmt.Println()
 
+	for _, w := range world.SchemaWarnings {
+		ui.Warn.Println(w)
+	}
+
 	if world.DiscoveryErr != "" {
 		ui.Warn.Printf("Discovery Warning: %s\n", world.DiscoveryErr)
 		fmt.Println()
@@ -187,7 +240,81 @@ func renderWorldTable(world status.WorldInfo) {
 	}
 }
 
+// renderWorldExport writes the world package, objects, and addresses as JSON
+// or as CSV rows of section,key,value for import into other tools.
+func renderWorldExport(w io.Writer, world status.WorldInfo, format string) error {
+	if format == "json" {
+		out := worldExport{PackageID: world.PackageID, Objects: world.Objects, Addresses: world.Addresses}
+		if out.Objects == nil {
+			out.Objects = map[string]string{}
+		}
+		if out.Addresses == nil {
+			out.Addresses = map[string]string{}
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(out)
+	}
+
+	t := table.NewWriter()
+	t.SetOutputMirror(w)
+	t.AppendHeader(table.Row{"Section", "Key", "Value"})
+	if world.PackageID != "" {
+		t.AppendRow(table.Row{"package", "World Package ID", world.PackageID})
+	}
+	for _, key := range sortedKeys(world.Objects) {
+		t.AppendRow(table.Row{"object", key, world.Objects[key]})
+	}
+	for _, key := range sortedKeys(world.Addresses) {
+		t.AppendRow(table.Row{"address", key, world.Addresses[key]})
+	}
+	t.RenderCSV()
+	return nil
+}
+
+// tsvContainerFormat is the template behind --format tsv.
+const tsvContainerFormat = "{{.Name}}\t{{.Status}}\t{{.CPU}}\t{{.Mem}}"
+
+// parseContainerFormat compiles a per-container Go template in the style of
+// `docker ps --format`. "tsv" is shorthand for tab-separated fields and a
+// literal `\t` in the template is treated as a tab, as docker does.
+func parseContainerFormat(format string) (*template.Template, error) {
+	if format == "tsv" {
+		format = tsvContainerFormat
+	}
+	if !strings.Contains(format, "{{") {
+		return nil, fmt.Errorf("no template actions found")
+	}
+	format = strings.ReplaceAll(format, `\t`, "\t")
+	return template.New("container").Option("missingkey=error").Parse(format)
+}
+
+// renderContainerExport executes tmpl once per container, one line each.
+// Fields of status.ContainerStat (Name, Status, CPU, Mem) are available.
+func renderContainerExport(w io.Writer, containers []status.ContainerStat, tmpl *template.Template) error {
+	for _, c := range containers {
+		if err := tmpl.Execute(w, c); err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintln(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 func init() {
-	envStatusCmd.Flags().StringVar(&envStatusRPCURL, "rpc-url", "http://localhost:9000", "Sui JSON-RPC endpoint URL")
+	envStatusCmd.Flags().StringVar(&envStatusRPCURL, "rpc-url", status.DefaultRPCURL, "Sui JSON-RPC endpoint URL (detected from the running container when not set)")
+	envStatusCmd.Flags().DurationVar(&envStatusRPCTimeout, "rpc-poll-timeout", status.DefaultRPCTimeout, "Timeout for each Sui RPC call (e.g. 3s)")
+	envStatusCmd.Flags().StringVar(&envStatusFormat, "format", "table", "Output format: table; json or csv for world objects and addresses; tsv or a Go template such as '{{.Name}} {{.Status}}' for containers")
 	envCmd.AddCommand(envStatusCmd)
 }
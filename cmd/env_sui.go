@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"os"
+
+	"efctl/pkg/sui"
+	"efctl/pkg/ui"
+
+	"github.com/spf13/cobra"
+)
+
+var envSuiResetYes bool
+
+var envSuiCmd = &cobra.Command{
+	Use:   "sui",
+	Short: "Manage the sui client configuration for the local environment",
+}
+
+var envSuiResetCmd = &cobra.Command{
+	Use:   "reset",
+	Short: "Remove the ef-localhost environment and efctl key aliases from the sui client",
+	Long: `Removes the ef-admin, ef-player-a and ef-player-b key aliases, plus the alias of
+any other *_PRIVATE_KEY in world-contracts/.env, and deletes the
+ef-localhost environment from the sui client configuration (~/.sui/sui_config/client.yaml).
+A backup of the original file is written alongside it as client.yaml.bak.
+
+Use this to recover from a corrupted sui client configuration; the next
+'efctl env up' recreates the environment and aliases.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if !envSuiResetYes && !ui.Confirm("Remove the ef-localhost environment and efctl key aliases from your sui client configuration?") {
+			ui.Warn.Println("Reset cancelled.")
+			return
+		}
+
+		if err := sui.ResetSui(workspacePath); err != nil {
+			ui.Error.Println("Sui reset failed: " + err.Error())
+			os.Exit(1)
+		}
+
+		ui.Success.Println("Sui client configuration reset.")
+	},
+}
+
+func init() {
+	envSuiResetCmd.Flags().BoolVarP(&envSuiResetYes, "yes", "y", false, "Skip the confirmation prompt")
+	envSuiCmd.AddCommand(envSuiResetCmd)
+	envCmd.AddCommand(envSuiCmd)
+}
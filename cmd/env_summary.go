@@ -0,0 +1,39 @@
+package cmd
+
+import (
+	"os"
+
+	"efctl/pkg/container"
+	"efctl/pkg/setup"
+	"efctl/pkg/ui"
+
+	"github.com/spf13/cobra"
+)
+
+var envSummaryCmd = &cobra.Command{
+	Use:   "summary",
+	Short: "Reprint the deployment summary of the running environment",
+	Long: `Prints the same packages, objects, addresses and URLs that env up shows when it
+finishes. The GraphQL and frontend URLs are listed when their containers are
+running.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		c, err := container.NewClient()
+		if err != nil {
+			ui.Error.Println("Failed to initialize container client: " + err.Error())
+			os.Exit(1)
+		}
+
+		if !c.ContainerRunning(container.ContainerSuiPlayground) {
+			ui.Error.Println("The environment is not running. Start it with `efctl env up`.")
+			os.Exit(1)
+		}
+
+		setup.PrintDeploymentSummary(workspacePath,
+			c.ContainerRunning(container.ContainerPostgres),
+			c.ContainerRunning(container.ContainerFrontend))
+	},
+}
+
+func init() {
+	envCmd.AddCommand(envSummaryCmd)
+}
@@ -1,16 +1,21 @@
 package cmd
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"efctl/pkg/config"
 	"efctl/pkg/container"
 	"efctl/pkg/env"
 	"efctl/pkg/git"
 	"efctl/pkg/setup"
+	"efctl/pkg/status"
 	"efctl/pkg/sui"
 	"efctl/pkg/ui"
 
@@ -32,6 +37,19 @@ var envUpCmd = &cobra.Command{
 				withFrontend = *cfg.WithFrontend
 			}
 		}
+		if !cmd.Flags().Changed("no-frontend-install") {
+			noFrontendInstall = !cfg.GetFrontendInstall()
+		}
+
+		ui.ProgressJSONEnabled = progressJSON
+
+		if dumpConfig {
+			if err := dumpContainerConfig(os.Stdout, workspacePath, withGraphql, withFrontend, noFrontendInstall); err != nil {
+				ui.Error.Println("Failed to render container configuration: " + err.Error())
+				os.Exit(1)
+			}
+			return
+		}
 
 		// Inform user if config file wasn't found; features are enabled by default.
 		if cfg != nil && !cfg.WasLoaded() {
@@ -39,119 +57,308 @@ var envUpCmd = &cobra.Command{
 			ui.Debug.Println("Create efctl.yaml to customize defaults (for example, set with-graphql/with-frontend to false).")
 		}
 
-		ui.Info.Println("Checking prerequisites...")
-		res := env.CheckPrerequisites()
-
-		if !res.HasNode {
-			ui.Error.Println("Node.js is not installed. Please install Node.js >= 20.0.0 to continue.")
-			os.Exit(1)
-		}
-		if strings.HasPrefix(res.NodeVer, "v") {
-			parts := strings.Split(res.NodeVer[1:], ".")
-			if len(parts) >= 1 {
-				major, err := strconv.Atoi(parts[0])
-				if err == nil {
-					if major < 20 {
-						ui.Error.Println("Node.js version must be 20.0.0 or higher. Found: " + res.NodeVer)
-						os.Exit(1)
-					} else if major != 24 {
-						ui.Warn.Println("Node.js version is within range but different from project standard (24.x.x). Found: " + res.NodeVer)
-					}
-				}
+		if freshUp {
+			if err := setup.ClearUpState(workspacePath); err != nil {
+				ui.Warn.Println("Could not clear previous env up progress: " + err.Error())
 			}
 		}
 
-		if !res.HasDocker && !res.HasPodman {
-			ui.Error.Println("Neither Docker nor Podman is installed. Please install one to continue.")
-			os.Exit(1)
-		}
-
-		if engine, _ := res.Engine(); engine == "podman" {
-			container.CheckPodmanConfig()
+		ctx := context.Background()
+		if upTimeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, upTimeout)
+			defer cancel()
 		}
 
-		if !res.HasGit {
-			ui.Error.Println("Git is not installed.")
-			os.Exit(1)
-		}
-		if !env.IsPortAvailable(9000) {
-			ui.Error.Println("Port 9000 is already in use by another process. Please free it up before initializing.")
-			os.Exit(1)
-		}
-		if withGraphql {
-			if !env.IsPortAvailable(8000) {
-				ui.Error.Println("Port 8000 (GraphQL) is already in use by another process. Please free it up.")
+		var state *setup.UpState
+		if resumeUp {
+			var err error
+			state, err = setup.ReadUpState(workspacePath)
+			if err != nil {
+				ui.Error.Println("Cannot resume: " + err.Error())
+				ui.Info.Println("Run `efctl env up --fresh` to start over.")
 				os.Exit(1)
 			}
-			if !env.IsPortAvailable(5432) {
-				ui.Error.Println("Port 5432 (PostgreSQL) is already in use by another process. Please free it up.")
-				os.Exit(1)
+			if state == nil {
+				ui.Info.Println("No previous env up progress found; running every phase.")
+			} else {
+				ui.Info.Println(fmt.Sprintf("Resuming after the %s phase completed at %s.", state.LastCompleted, state.UpdatedAt.Local().Format("2006-01-02 15:04:05")))
 			}
 		}
-		if withFrontend {
-			if !env.IsPortAvailable(5173) {
-				ui.Error.Println("Port 5173 (Frontend) is already in use by another process. Please free it up.")
+
+		switch {
+		case onlyStart:
+			if err := setup.RequireRepositories(workspacePath); err != nil {
+				ui.Phase(string(setup.PhaseClone), ui.PhaseFailed, err.Error())
+				ui.Error.Println("Cannot use --only-start: " + err.Error())
+				ui.Info.Println("Run `efctl env up` without --only-start to clone them.")
 				os.Exit(1)
 			}
-		}
+			ui.Phase(string(setup.PhaseClone), ui.PhaseSkipped)
+		case skipUpPhase(state, setup.PhaseClone, "Skipping setup: repositories were cloned by a previous run."):
+		default:
+			checkUpPrerequisites()
+			ui.Phase(string(setup.PhaseClone), ui.PhaseStarted)
 
-		ui.Info.Println("Setting up workspace...")
-		if err := setup.CloneRepositories(git.NewClient(), workspacePath); err != nil {
-			ui.Error.Println("Setup failed: " + err.Error())
-			ui.Warn.Println("The environment may be partially initialized. It is recommended to run `efctl env down` before trying again.")
-			os.Exit(1)
+			ui.Info.Println("Setting up workspace...")
+			cloneOpts := setup.CloneOptions{BranchFallback: branchFallback, KeepGoing: keepGoing}
+			if err := setup.CloneRepositories(ctx, git.NewClient(), workspacePath, cloneOpts); err != nil {
+				ui.Phase(string(setup.PhaseClone), ui.PhaseFailed, err.Error())
+				reportUpTimeout(ctx, setup.PhaseClone)
+				ui.Error.Println("Setup failed: " + err.Error())
+				ui.Warn.Println("The environment may be partially initialized. It is recommended to run `efctl env down` before trying again.")
+				os.Exit(1)
+			}
+			recordUpPhase(setup.PhaseClone)
 		}
 
-		ui.Info.Println("Starting environment...")
-
 		c, err := container.NewClientWithNetwork(workspacePath)
 		if err != nil {
+			ui.Phase(string(setup.PhaseStart), ui.PhaseFailed, err.Error())
 			ui.Error.Println("Failed to create container client: " + err.Error())
 			os.Exit(1)
 		}
 
-		if err := setup.StartEnvironment(c, workspacePath, withGraphql, withFrontend); err != nil {
-			ui.Error.Println("Start failed: " + err.Error())
-			ui.Warn.Println("The environment may be partially initialized. It is recommended to run `efctl env down` before trying again.")
-			os.Exit(1)
+		if !skipUpPhase(state, setup.PhaseStart, "Skipping start: containers were started by a previous run.") {
+			ui.Phase(string(setup.PhaseStart), ui.PhaseStarted)
+			ui.Info.Println("Starting environment...")
+			if err := setup.StartEnvironment(ctx, c, workspacePath, withGraphql, withFrontend, noFrontendInstall); err != nil {
+				ui.Phase(string(setup.PhaseStart), ui.PhaseFailed, err.Error())
+				reportUpTimeout(ctx, setup.PhaseStart)
+				ui.Error.Println("Start failed: " + err.Error())
+				ui.Warn.Println("The environment may be partially initialized. Fix the problem and run `efctl env up --resume`, or run `efctl env down` before trying again.")
+				os.Exit(1)
+			}
+			recordUpPhase(setup.PhaseStart)
 		}
 
-		ui.Info.Println("Deploying world contracts...")
-		if err := setup.DeployWorld(c, workspacePath); err != nil {
-			ui.Error.Println("Deployment failed: " + err.Error())
-			ui.Warn.Println("The environment may be partially initialized. It is recommended to run `efctl env down` before trying again.")
-			os.Exit(1)
+		if !skipUpPhase(state, setup.PhaseDeploy, "Skipping deploy: world contracts were deployed by a previous run.") {
+			ui.Phase(string(setup.PhaseDeploy), ui.PhaseStarted)
+			ui.Info.Println("Deploying world contracts...")
+			if err := setup.DeployWorld(ctx, c, workspacePath); err != nil {
+				ui.Phase(string(setup.PhaseDeploy), ui.PhaseFailed, err.Error())
+				reportUpTimeout(ctx, setup.PhaseDeploy)
+				ui.Error.Println("Deployment failed: " + err.Error())
+				ui.Warn.Println("The environment may be partially initialized. Fix the problem and run `efctl env up --resume` to retry the deploy, or run `efctl env down` before trying again.")
+				os.Exit(1)
+			}
+			recordUpPhase(setup.PhaseDeploy)
+		}
+
+		if err := sui.RecordDeployMeta(workspacePath); err != nil {
+			ui.Warn.Println("Could not record deploy metadata: " + err.Error())
 		}
 
 		if sui.IsSuiInstalled() {
-			if err := sui.ConfigureSui(workspacePath); err != nil {
+			ui.Phase(phaseConfigure, ui.PhaseStarted)
+			if _, err := sui.ConfigureSui(workspacePath); err != nil {
+				ui.Phase(phaseConfigure, ui.PhaseFailed, err.Error())
 				ui.Warn.Println("Sui client configuration failed: " + err.Error())
 			} else {
+				ui.Phase(phaseConfigure, ui.PhaseDone)
 				ui.Info.Println("Sui client has been configured for this environment.")
 				fmt.Println("Try running these commands to test:")
 				fmt.Println("  sui client active-env")
 				fmt.Println("  sui client addresses")
 				fmt.Println()
 			}
+		} else {
+			ui.Phase(phaseConfigure, ui.PhaseSkipped, "sui CLI not found")
+			ui.Warn.Println("Sui CLI not found; skipping sui client configuration.")
+			for _, line := range sui.LookupDiagnostics() {
+				ui.Info.Println(line)
+			}
 		}
 
-		setup.PrintDeploymentSummary(workspacePath)
+		if err := setup.ClearUpState(workspacePath); err != nil {
+			ui.Warn.Println("Could not clear env up progress marker: " + err.Error())
+		}
 
-		if withFrontend {
-			fmt.Println("\n" + ui.GlobeEmoji + " Frontend dApp: http://localhost:5173")
+		if !noSummary {
+			setup.PrintDeploymentSummary(workspacePath, withGraphql, withFrontend)
 		}
+		printUpHealth(c.Engine, withGraphql, withFrontend)
 
+		ui.Phase(phaseUp, ui.PhaseDone)
 		ui.Success.Println(fmt.Sprintf("%s Environment is up! The Sui playground is running and gates are spawned.", ui.GlobeEmoji))
 		ui.Info.Println("To get test tokens for an address, run: efctl env faucet --address <your-sui-account>")
 		ui.Info.Println("if you experience ANY problems with efctl please run efctl doctor and create a GitHub issue at https://github.com/scetrov/efctl with as much information as possible to help us diagnose the issue")
 	},
 }
 
+// Progress phases reported by --progress-json besides the resumable
+// setup.Phase steps: the prerequisite checks, sui client configuration, and
+// the whole run finishing.
+const (
+	phasePrerequisites = "prerequisites"
+	phaseConfigure     = "configure"
+	phaseUp            = "up"
+)
+
+// skipUpPhase reports whether a previous run already completed phase, printing
+// msg when it did so the user can see which steps --resume left out.
+func skipUpPhase(state *setup.UpState, phase setup.Phase, msg string) bool {
+	if !state.Done(phase) {
+		return false
+	}
+	ui.Phase(string(phase), ui.PhaseSkipped)
+	ui.Info.Println(msg)
+	return true
+}
+
+// reportUpTimeout explains a phase failure caused by the --timeout deadline,
+// naming the phase that was cut short.
+func reportUpTimeout(ctx context.Context, phase setup.Phase) {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		ui.Error.Println(fmt.Sprintf("env up timed out after %s during the %s phase.", upTimeout, phase))
+	}
+}
+
+// recordUpPhase marks phase as completed so a later `env up --resume` can skip
+// it. Failing to write the marker only costs a re-run, so it is a warning.
+func recordUpPhase(phase setup.Phase) {
+	ui.Phase(string(phase), ui.PhaseDone)
+	if err := setup.MarkPhaseComplete(workspacePath, phase); err != nil {
+		ui.Warn.Println("Could not record env up progress: " + err.Error())
+	}
+}
+
+// upHealthTimeout bounds the post-up status probe so a wedged node cannot
+// hang env up after a successful deploy.
+const upHealthTimeout = 15 * time.Second
+
+// printUpHealth probes the freshly started environment and prints a one-line
+// health summary, so a deploy that returned but left the chain wedged is
+// noticed immediately.
+func printUpHealth(engine string, withGraphql, withFrontend bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), upHealthTimeout)
+	defer cancel()
+
+	expected := []string{container.ContainerSuiPlayground}
+	if withGraphql {
+		expected = append(expected, container.ContainerPostgres)
+	}
+	if withFrontend {
+		expected = append(expected, container.ContainerFrontend)
+	}
+
+	st := status.Gather(ctx, engine, workspacePath, status.DetectRPCURL(ctx, engine))
+	line, healthy := status.HealthSummary(st, expected)
+	if healthy {
+		ui.Success.Println(line)
+	} else {
+		ui.Warn.Println(line)
+	}
+}
+
+// checkUpPrerequisites exits when a required tool is missing or a service
+// port is already taken.
+func checkUpPrerequisites() {
+	ui.Phase(phasePrerequisites, ui.PhaseStarted)
+	ui.Info.Println("Checking prerequisites...")
+	res := env.CheckPrerequisites()
+
+	if !res.HasNode {
+		failPrerequisite("Node.js is not installed. Please install Node.js >= 20.0.0 to continue.")
+	}
+	if strings.HasPrefix(res.NodeVer, "v") {
+		parts := strings.Split(res.NodeVer[1:], ".")
+		if len(parts) >= 1 {
+			major, err := strconv.Atoi(parts[0])
+			if err == nil {
+				if major < 20 {
+					failPrerequisite("Node.js version must be 20.0.0 or higher. Found: " + res.NodeVer)
+				} else if major != 24 {
+					ui.Warn.Println("Node.js version is within range but different from project standard (24.x.x). Found: " + res.NodeVer)
+				}
+			}
+		}
+	}
+
+	if !res.HasDocker && !res.HasPodman {
+		failPrerequisite("Neither Docker nor Podman is installed. Please install one to continue.")
+	}
+
+	if engine, _ := res.Engine(); engine == "podman" {
+		container.CheckPodmanConfig()
+	}
+
+	if !res.HasGit {
+		failPrerequisite("Git is not installed.")
+	}
+	if !env.IsPortAvailable(9000) {
+		failPrerequisite("Port 9000 is already in use by another process. Please free it up before initializing.")
+	}
+	if withGraphql {
+		if !env.IsPortAvailable(8000) {
+			failPrerequisite("Port 8000 (GraphQL) is already in use by another process. Please free it up.")
+		}
+		if !env.IsPortAvailable(5432) {
+			failPrerequisite("Port 5432 (PostgreSQL) is already in use by another process. Please free it up.")
+		}
+	}
+	if withFrontend {
+		if !env.IsPortAvailable(5173) {
+			failPrerequisite("Port 5173 (Frontend) is already in use by another process. Please free it up.")
+		}
+	}
+	ui.Phase(phasePrerequisites, ui.PhaseDone)
+}
+
+// failPrerequisite reports a missing prerequisite and exits.
+func failPrerequisite(msg string) {
+	ui.Phase(phasePrerequisites, ui.PhaseFailed, msg)
+	ui.Error.Println(msg)
+	os.Exit(1)
+}
+
 var withGraphql = true
 var withFrontend = true
+var noFrontendInstall bool
+var dumpConfig bool
+var onlyStart bool
+var branchFallback bool
+var keepGoing bool
+var resumeUp bool
+var freshUp bool
+var noSummary bool
+var progressJSON bool
+var upTimeout time.Duration
+
+// dumpContainerConfig prints the create command for every container env up
+// would start, with the postgres password and any secrets redacted.
+func dumpContainerConfig(w io.Writer, workspace string, withGraphql, withFrontend, skipInstall bool) error {
+	c, err := container.NewClientWithNetwork(workspace)
+	if err != nil {
+		return err
+	}
+	cfgs, err := setup.ServiceConfigs(c, workspace, withGraphql, withFrontend, skipInstall, setup.RedactedPostgresPassword)
+	if err != nil {
+		return err
+	}
+	for i, cfg := range cfgs {
+		if i > 0 {
+			_, _ = fmt.Fprintln(w)
+		}
+		args := c.CreateCommand(cfg)
+		_, _ = fmt.Fprintf(w, "# %s\n%s\n", cfg.Name, ui.Redact(ui.FormatCommand(args[0], args[1:]...)))
+	}
+	return nil
+}
 
 func init() {
 	envUpCmd.Flags().BoolVar(&withGraphql, "with-graphql", true, "Enable the SQL Indexer and GraphQL API")
 	envUpCmd.Flags().BoolVar(&withFrontend, "with-frontend", true, "Enable the builder-scaffold web frontend (Vite dev server on port 5173)")
+	envUpCmd.Flags().BoolVar(&noFrontendInstall, "no-frontend-install", false, "Skip pnpm install in the frontend container when node_modules is already populated")
+	envUpCmd.Flags().BoolVar(&dumpConfig, "dump-config", false, "Print the container create commands env up would run, then exit without starting anything")
+	envUpCmd.Flags().BoolVar(&onlyStart, "only-start", false, "Skip prerequisite checks and cloning; start containers and deploy using repositories already in the workspace")
+	envUpCmd.Flags().BoolVar(&branchFallback, "branch-fallback", false, "Stay on the default branch with a warning when a configured ref does not exist, instead of failing")
+	envUpCmd.Flags().BoolVar(&keepGoing, "keep-going", false, "Attempt every repository clone even if one fails, and report all failures together")
+	envUpCmd.Flags().BoolVar(&resumeUp, "resume", false, "Skip phases (clone, start, deploy) that completed in the previous env up run and continue from the one that failed")
+	envUpCmd.Flags().DurationVar(&upTimeout, "timeout", 0, "Abort clone, start, and deploy if env up takes longer than this (for example 30m); 0 means no limit")
+	envUpCmd.Flags().BoolVar(&progressJSON, "progress-json", false, "Emit phase transitions and spinner states as JSON lines on stderr for tools that drive efctl")
+	envUpCmd.Flags().BoolVar(&noSummary, "no-summary", false, "Skip the deployment summary tables; progress, the health line, and the success message are still printed")
+	envUpCmd.Flags().BoolVar(&freshUp, "fresh", false, "Discard the progress recorded by a previous env up run and run every phase")
+	envUpCmd.MarkFlagsMutuallyExclusive("resume", "fresh")
 	envCmd.AddCommand(envUpCmd)
 }
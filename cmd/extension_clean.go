@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"os"
+
+	"efctl/pkg/builder"
+	"efctl/pkg/ui"
+	"efctl/pkg/validate"
+
+	"github.com/spf13/cobra"
+)
+
+var extensionCleanCmd = &cobra.Command{
+	Use:   "clean",
+	Short: "Reset extension publish state so the next publish starts from scratch",
+	Long: `Removes the ephemeral builder-scaffold/deployments/<network>/Pub.extension.toml and
+clears BUILDER_PACKAGE_ID and EXTENSION_CONFIG_ID in builder-scaffold/.env.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := validate.Network(envNetwork); err != nil {
+			ui.Error.Println(err.Error())
+			os.Exit(1)
+		}
+
+		cleaned, err := builder.CleanPublishState(workspacePath, envNetwork)
+		for _, item := range cleaned {
+			ui.Info.Println(item)
+		}
+		if err != nil {
+			ui.Error.Println("Clean failed: " + err.Error())
+			os.Exit(1)
+		}
+
+		if len(cleaned) == 0 {
+			ui.Info.Println("No extension publish state to clean.")
+			return
+		}
+		ui.Success.Println("Extension publish state cleaned.")
+	},
+}
+
+func init() {
+	extensionCleanCmd.Flags().StringVarP(&envNetwork, "network", "n", "localnet", "The network whose publish state to clean (localnet or testnet)")
+	extensionCmd.AddCommand(extensionCleanCmd)
+}
@@ -37,6 +37,6 @@ var envNetwork string
 func init() {
 	extensionInitCmd.Flags().StringVarP(&envNetwork, "network", "n", "localnet", "The network to copy artifacts from (localnet or testnet)")
 	// Inherit workspacePath which is set in root.go or typically handled by persistent flags (Wait, is workspacePath global in cmd?)
-	extensionInitCmd.Flags().StringVarP(&workspacePath, "workspace", "w", ".", "Path to the workspace directory")
+	extensionInitCmd.Flags().StringVarP(&workspacePath, "workspace", "w", ".", "Path to the workspace directory (overrides EFCTL_WORKSPACE)")
 	extensionCmd.AddCommand(extensionInitCmd)
 }
@@ -1,6 +1,8 @@
 package cmd
 
 import (
+	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -14,9 +16,12 @@ import (
 
 var extensionListCmd = &cobra.Command{
 	Use:   "list",
-	Short: "List all available extensions in the workspace",
-	Long:  `Scans the current configured workspace for extensions and displays them in a table format showing their container and local paths.`,
-	Args:  cobra.NoArgs,
+	Short: "List available and published extensions in the workspace",
+	Long: `Scans the current configured workspace for extensions and displays them in a table format showing their container and local paths.
+
+Published package and config IDs found in builder-scaffold/.env and in the
+builder-scaffold/deployments pubfiles are listed in a second table.`,
+	Args: cobra.NoArgs,
 	Run: func(cmd *cobra.Command, args []string) {
 		searchRoots, err := builder.GetPublishSearchRoots(workspacePath)
 		if err != nil {
@@ -30,31 +35,57 @@ var extensionListCmd = &cobra.Command{
 			os.Exit(1)
 		}
 
+		published, err := builder.ListPublished(workspacePath)
+		if err != nil {
+			ui.Warn.Println("Failed to read published extensions: " + err.Error())
+		}
+
 		if len(candidates) == 0 {
 			ui.Info.Println("No extensions found in the current workspace.")
-			return
+		} else {
+			renderCandidateTable(candidates)
 		}
 
-		t := table.NewWriter()
-		t.SetOutputMirror(os.Stdout)
-		t.AppendHeader(table.Row{"Container Path", "Local Path"})
-		t.SetStyle(table.StyleRounded)
+		if len(published) > 0 {
+			fmt.Println()
+			ui.Info.Println("Published Extensions")
+			renderPublishedTable(os.Stdout, published)
+		}
+	},
+}
 
-		for _, candidate := range candidates {
-			// Container path: relative to /workspace
-			relContainer := strings.TrimPrefix(candidate.ContainerPath, "/workspace/")
+func renderCandidateTable(candidates []builder.PublishCandidate) {
+	t := table.NewWriter()
+	t.SetOutputMirror(os.Stdout)
+	t.AppendHeader(table.Row{"Container Path", "Local Path"})
+	t.SetStyle(table.StyleRounded)
 
-			// Local path: relative to workspacePath
-			relLocal, err := filepath.Rel(workspacePath, candidate.HostPath)
-			if err != nil {
-				relLocal = candidate.HostPath
-			}
+	for _, candidate := range candidates {
+		// Container path: relative to /workspace
+		relContainer := strings.TrimPrefix(candidate.ContainerPath, "/workspace/")
 
-			t.AppendRow(table.Row{relContainer, relLocal})
+		// Local path: relative to workspacePath
+		relLocal, err := filepath.Rel(workspacePath, candidate.HostPath)
+		if err != nil {
+			relLocal = candidate.HostPath
 		}
 
-		t.Render()
-	},
+		t.AppendRow(table.Row{relContainer, relLocal})
+	}
+
+	t.Render()
+}
+
+// renderPublishedTable lists published IDs with the file they were read from.
+func renderPublishedTable(w io.Writer, entries []builder.PublishedEntry) {
+	t := table.NewWriter()
+	t.SetOutputMirror(w)
+	t.AppendHeader(table.Row{"Source", "Name", "ID"})
+	t.SetStyle(table.StyleRounded)
+	for _, e := range entries {
+		t.AppendRow(table.Row{e.Origin, e.Name, e.ID})
+	}
+	t.Render()
 }
 
 func init() {
@@ -6,6 +6,8 @@ import (
 	"path/filepath"
 	"testing"
 
+	"efctl/pkg/builder"
+
 	"github.com/pterm/pterm"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -85,3 +87,18 @@ func TestExtensionListCommand_Empty(t *testing.T) {
 
 	assert.Contains(t, output, "No extensions found in the current workspace.")
 }
+
+func TestRenderPublishedTable(t *testing.T) {
+	var buf bytes.Buffer
+	renderPublishedTable(&buf, []builder.PublishedEntry{
+		{Origin: ".env", Name: "BUILDER_PACKAGE_ID", ID: "0xPKG"},
+		{Origin: "deployments/localnet/Pub.extension.toml", Name: "builder-scaffold/move-contracts/my_ext", ID: "0xEXT"},
+	})
+
+	output := buf.String()
+	assert.Contains(t, output, "SOURCE")
+	assert.Contains(t, output, "BUILDER_PACKAGE_ID")
+	assert.Contains(t, output, "0xPKG")
+	assert.Contains(t, output, "builder-scaffold/move-contracts/my_ext")
+	assert.Contains(t, output, "0xEXT")
+}
@@ -1,15 +1,25 @@
 package cmd
 
 import (
+	"encoding/json"
+	"io"
 	"os"
 
 	"efctl/pkg/builder"
 	"efctl/pkg/container"
+	"efctl/pkg/sui"
 	"efctl/pkg/ui"
 	"efctl/pkg/validate"
+
+	"github.com/pterm/pterm"
 	"github.com/spf13/cobra"
 )
 
+var (
+	extensionPublishJSON     bool
+	extensionPublishBuildEnv string
+)
+
 var extensionPublishCmd = &cobra.Command{
 	Use:   "publish [extension-path]",
 	Short: "Publish a custom extension contract",
@@ -17,10 +27,27 @@ var extensionPublishCmd = &cobra.Command{
 	Args:  cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		extensionPath := args[0]
+
+		// In JSON mode stdout carries only the result; progress and publish
+		// logs go to stderr instead.
+		stdout := os.Stdout
+		if extensionPublishJSON {
+			os.Stdout = os.Stderr
+			pterm.SetDefaultOutput(os.Stderr)
+			defer func() {
+				os.Stdout = stdout
+				pterm.SetDefaultOutput(stdout)
+			}()
+		}
+
 		if err := validate.Network(envNetwork); err != nil {
 			ui.Error.Println(err.Error())
 			os.Exit(1)
 		}
+		if err := validate.BuildEnv(extensionPublishBuildEnv); err != nil {
+			ui.Error.Println(err.Error())
+			os.Exit(1)
+		}
 
 		c, err := container.NewClient()
 		if err != nil {
@@ -41,16 +68,36 @@ var extensionPublishCmd = &cobra.Command{
 			os.Exit(1)
 		}
 
-		if err := builder.PublishExtension(c, workspacePath, envNetwork, candidate); err != nil {
+		sui.WarnOnVersionDrift(workspacePath)
+
+		result, err := builder.PublishExtension(c, workspacePath, envNetwork, extensionPublishBuildEnv, candidate)
+		if err != nil {
 			ui.Error.Println("Publish failed: " + err.Error())
 			os.Exit(1)
 		}
 
 		ui.Success.Println("Extension contract published successfully.")
+
+		if extensionPublishJSON {
+			if err := writePublishResult(stdout, result); err != nil {
+				ui.Error.Println("Failed to write publish result: " + err.Error())
+				os.Exit(1)
+			}
+		}
 	},
 }
 
+// writePublishResult writes the published IDs as a single JSON object.
+func writePublishResult(w io.Writer, result builder.PublishResult) error {
+	if result.CreatedObjects == nil {
+		result.CreatedObjects = []builder.CreatedObject{}
+	}
+	return json.NewEncoder(w).Encode(result)
+}
+
 func init() {
 	extensionPublishCmd.Flags().StringVarP(&envNetwork, "network", "n", "localnet", "The network to publish to (localnet or testnet)")
+	extensionPublishCmd.Flags().StringVar(&extensionPublishBuildEnv, "build-env", "testnet", "Move build environment passed to sui (testnet, mainnet, or devnet)")
+	extensionPublishCmd.Flags().BoolVar(&extensionPublishJSON, "json", false, "Print the published IDs as JSON on stdout; progress output goes to stderr")
 	extensionCmd.AddCommand(extensionPublishCmd)
 }
@@ -4,17 +4,27 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"efctl/pkg/config"
+	"efctl/pkg/httpclient"
+	"efctl/pkg/setup"
+	"efctl/pkg/sui"
 	"efctl/pkg/ui"
 	"efctl/pkg/validate"
 	"github.com/spf13/cobra"
 )
 
 var (
-	configFile string
-	debugMode  bool
-	noProgress bool
+	configFile  string
+	debugMode   bool
+	verbose     bool
+	noProgress  bool
+	noEmoji     bool
+	colorMode   string
+	suiBinary   string
+	noProxy     bool
+	insecureTLS bool
 )
 
 var rootCmd = &cobra.Command{
@@ -26,12 +36,34 @@ var rootCmd = &cobra.Command{
 		if debugMode {
 			ui.DebugEnabled = true
 		}
+		setup.EfctlVersion = Version
+		if verbose {
+			ui.VerboseEnabled = true
+		}
 
 		// Disable progress spinner if explicitly requested or running in CI.
 		if noProgress || os.Getenv("CI") == "true" {
 			ui.ProgressEnabled = false
 		}
 
+		if noEmoji {
+			ui.SetNoEmoji()
+		}
+
+		if err := ui.SetColorMode(colorMode); err != nil {
+			ui.Error.Println(err.Error())
+			os.Exit(1)
+		}
+
+		if suiBinary != "" {
+			sui.SetBinary(suiBinary)
+		}
+		httpclient.NoProxy = noProxy
+		httpclient.InsecureSkipTLSVerify = insecureTLS
+		if insecureTLS {
+			ui.Warn.Println("TLS certificate verification is DISABLED for RPC and GraphQL endpoints (--insecure-skip-tls-verify). Only use this with trusted internal endpoints.")
+		}
+
 		if cmd == initCmd {
 			return
 		}
@@ -46,7 +78,11 @@ var rootCmd = &cobra.Command{
 			}
 		}
 
-		cfg, err := config.Load(resolvedConfigPath)
+		load := config.Load
+		if cmd.Flags().Changed("config-file") {
+			load = config.LoadRequired
+		}
+		cfg, err := load(resolvedConfigPath)
 		if err != nil {
 			ui.Error.Println("Failed to load config: " + err.Error())
 			os.Exit(1)
@@ -60,34 +96,77 @@ var rootCmd = &cobra.Command{
 			ui.Debug.Println("Loaded configuration from: " + resolvedConfigPath)
 		}
 
-		// Resolve workspacePath to an absolute path so that bind-mount
-		// sources are correct regardless of the container daemon's cwd.
+		// EFCTL_WORKSPACE supplies the workspace when -w/--workspace is not given.
+		workspaceFromEnv := false
+		if f := cmd.Flags().Lookup("workspace"); f != nil {
+			if envWorkspace, ok := workspaceEnvOverride(f.Changed, os.Getenv); ok {
+				ui.Debug.Println("Using workspace from EFCTL_WORKSPACE: " + envWorkspace)
+				// Set through the flag so commands with their own workspace
+				// variable (e.g. doctor) pick it up too.
+				_ = f.Value.Set(envWorkspace)
+				workspaceFromEnv = true
+			}
+		}
+
+		// Resolve workspacePath to an absolute, symlink-free path once so that
+		// bind-mount sources and every filepath.Join agree regardless of how
+		// the workspace was spelled or the container daemon's cwd.
 		if workspacePath != "" {
-			abs, absErr := filepath.Abs(workspacePath)
-			if absErr == nil {
-				ui.Debug.Println("Resolved workspace path: " + abs)
-				workspacePath = abs
+			resolved, resolveErr := setup.ResolveWorkspacePath(workspacePath)
+			if resolveErr == nil {
+				ui.Debug.Println("Resolved workspace path: " + resolved)
+				workspacePath = resolved
 			}
 		}
 
-		// Validate workspace path if a workspace-aware command is being used
-		if workspacePath != "" && workspacePath != "." {
+		// Reject unsafe workspaces (including the default current directory)
+		// before any workspace-aware command clones, mounts, or deletes files.
+		if isWorkspaceCommand(cmd) {
 			if err := validate.WorkspacePath(workspacePath); err != nil {
+				if workspaceFromEnv {
+					ui.Error.Println("Invalid workspace path from EFCTL_WORKSPACE: " + err.Error())
+					os.Exit(1)
+				}
 				ui.Error.Println("Invalid workspace path: " + err.Error())
+				ui.Info.Println("Run efctl from a project directory or pass --workspace <dir>.")
 				os.Exit(1)
 			}
 		}
 	},
 }
 
+// isWorkspaceCommand reports whether cmd operates on the shared workspacePath,
+// i.e. it is an env subcommand or `extension init`.
+func isWorkspaceCommand(cmd *cobra.Command) bool {
+	if cmd == extensionInitCmd {
+		return true
+	}
+	for c := cmd; c != nil; c = c.Parent() {
+		if c == envCmd {
+			return true
+		}
+	}
+	return false
+}
+
 func init() {
 	rootCmd.PersistentFlags().StringVar(&configFile, "config-file", config.DefaultConfigFile, "Path to the efctl.yaml or efctl.yml configuration file")
+	rootCmd.PersistentFlags().StringVar(&colorMode, "color", ui.ColorAuto, "When to use colored output: auto, always, or never (auto honours NO_COLOR and disables color when not a terminal)")
 	rootCmd.PersistentFlags().BoolVar(&debugMode, "debug", false, "Enable verbose debug logging")
+	rootCmd.PersistentFlags().BoolVar(&noEmoji, "no-emoji", false, "Replace emoji with ASCII tags and use a high-contrast palette (or set EFCTL_NO_EMOJI)")
+	rootCmd.PersistentFlags().BoolVar(&noProxy, "no-proxy", false, "Connect directly, ignoring HTTP_PROXY, HTTPS_PROXY, and NO_PROXY")
+	rootCmd.PersistentFlags().BoolVar(&insecureTLS, "insecure-skip-tls-verify", false, "Skip TLS certificate verification for RPC and GraphQL endpoints (for self-signed internal deployments; insecure)")
 	rootCmd.PersistentFlags().BoolVar(&noProgress, "no-progress", false, "Disable the progress spinner for cleaner CI output")
+	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "Print every docker/podman, git, and sui command efctl runs (to stderr)")
+	rootCmd.PersistentFlags().StringVar(&suiBinary, "sui-binary", "", "Path to the sui executable (overrides EFCTL_SUI_BIN and PATH lookup)")
 }
 
 // Execute adds all child commands to the root command and sets flags appropriately.
 func Execute() {
+	// The banner is printed before flags are parsed, so honour --color early.
+	if mode, ok := colorFlagFromArgs(os.Args[1:]); ok {
+		_ = ui.SetColorMode(mode)
+	}
 	ui.PrintBanner()
 	if err := rootCmd.Execute(); err != nil {
 		ui.Error.Println(err.Error())
@@ -97,6 +176,41 @@ func Execute() {
 	}
 }
 
+// workspaceEnvOverride returns the EFCTL_WORKSPACE value, with a leading "~"
+// expanded, when the --workspace flag was not set explicitly.
+func workspaceEnvOverride(flagChanged bool, getenv func(string) string) (string, bool) {
+	if flagChanged {
+		return "", false
+	}
+	value := getenv("EFCTL_WORKSPACE")
+	if value == "" {
+		return "", false
+	}
+	if value == "~" || strings.HasPrefix(value, "~/") {
+		if home, err := os.UserHomeDir(); err == nil {
+			value = filepath.Join(home, strings.TrimPrefix(value, "~"))
+		}
+	}
+	return value, true
+}
+
+// colorFlagFromArgs returns the value of --color from raw arguments, supporting
+// both "--color=never" and "--color never" forms.
+func colorFlagFromArgs(args []string) (string, bool) {
+	for i, arg := range args {
+		if arg == "--" {
+			break
+		}
+		if v, ok := strings.CutPrefix(arg, "--color="); ok {
+			return v, true
+		}
+		if arg == "--color" && i+1 < len(args) {
+			return args[i+1], true
+		}
+	}
+	return "", false
+}
+
 // GetRootCmd returns the root cobra command
 func GetRootCmd() *cobra.Command {
 	return rootCmd
@@ -114,8 +228,14 @@ func GetNewRootCmd() *cobra.Command {
 		PersistentPreRun: rootCmd.PersistentPreRun,
 	}
 	newRoot.PersistentFlags().StringVar(&configFile, "config-file", config.DefaultConfigFile, "Path to the efctl.yaml or efctl.yml configuration file")
+	newRoot.PersistentFlags().StringVar(&colorMode, "color", ui.ColorAuto, "When to use colored output: auto, always, or never (auto honours NO_COLOR and disables color when not a terminal)")
 	newRoot.PersistentFlags().BoolVar(&debugMode, "debug", false, "Enable verbose debug logging")
+	newRoot.PersistentFlags().BoolVar(&noEmoji, "no-emoji", false, "Replace emoji with ASCII tags and use a high-contrast palette (or set EFCTL_NO_EMOJI)")
+	newRoot.PersistentFlags().BoolVar(&noProxy, "no-proxy", false, "Connect directly, ignoring HTTP_PROXY, HTTPS_PROXY, and NO_PROXY")
+	newRoot.PersistentFlags().BoolVar(&insecureTLS, "insecure-skip-tls-verify", false, "Skip TLS certificate verification for RPC and GraphQL endpoints (for self-signed internal deployments; insecure)")
 	newRoot.PersistentFlags().BoolVar(&noProgress, "no-progress", false, "Disable the progress spinner for cleaner CI output")
+	newRoot.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "Print every docker/podman, git, and sui command efctl runs (to stderr)")
+	newRoot.PersistentFlags().StringVar(&suiBinary, "sui-binary", "", "Path to the sui executable (overrides EFCTL_SUI_BIN and PATH lookup)")
 
 	// Re-add subcommands... This is getting complex because they are added in init()
 	// Let's try a different approach: manually reset the Changed property of flags.
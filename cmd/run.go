@@ -4,10 +4,14 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path"
 	"regexp"
+	"strings"
 
 	"efctl/pkg/container"
+	"efctl/pkg/sui"
 	"efctl/pkg/ui"
+	"efctl/pkg/validate"
 
 	"github.com/spf13/cobra"
 )
@@ -15,11 +19,27 @@ import (
 // safeScriptNameRe matches only safe script/command names (alphanumeric, hyphens, underscores, dots, slashes).
 var safeScriptNameRe = regexp.MustCompile(`^[a-zA-Z0-9_./-]+$`)
 
+// safeEnvValueRe matches --env values that are safe to pass to scripts.
+var safeEnvValueRe = regexp.MustCompile(`^[a-zA-Z0-9_./:@,=+-]*$`)
+
+var (
+	runInteractive bool
+	runWorkdir     string
+	runEnv         []string
+)
+
+// defaultRunWorkdir is the directory under /workspace scripts run in.
+const defaultRunWorkdir = "builder-scaffold"
+
 var runCmd = &cobra.Command{
 	Use:   "run [script-name]",
 	Short: "Run a script in the builder-scaffold container",
-	Long:  `Runs a predefined script (e.g. from package.json) or a custom arbitrary bash command directly inside the container in the /workspace/builder-scaffold directory.`,
-	Args:  cobra.MinimumNArgs(1),
+	Long: `Runs a predefined script (e.g. from package.json) or a custom arbitrary bash command directly inside the container in the /workspace/builder-scaffold directory.
+Use --workdir to run from another directory under /workspace, such as world-contracts.
+
+If the script fails, efctl exits with the script's exit code. Use --interactive
+for scripts that prompt for input.`,
+	Args: cobra.MinimumNArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		scriptName := args[0]
 		scriptArgs := args[1:]
@@ -35,6 +55,18 @@ var runCmd = &cobra.Command{
 				os.Exit(1)
 			}
 		}
+		if err := validate.ContainerWorkdir(runWorkdir); err != nil {
+			ui.Error.Println("Invalid --workdir: " + err.Error())
+			os.Exit(1)
+		}
+		for _, pair := range runEnv {
+			if err := validateRunEnv(pair); err != nil {
+				ui.Error.Println("Invalid --env: " + err.Error())
+				os.Exit(1)
+			}
+		}
+
+		sui.WarnOnVersionDrift(workspacePath)
 
 		ui.Info.Printf("Running script '%s' inside the container...\n", scriptName)
 
@@ -44,32 +76,62 @@ var runCmd = &cobra.Command{
 			os.Exit(1)
 		}
 
-		// Build the command using exec "$@" pattern to avoid shell metacharacter interpretation.
-		// Arguments are passed as separate exec.Command args, not interpolated into a shell string.
-		execArgs := []string{
-			"/bin/bash", "-c",
-			`cd /workspace/builder-scaffold && exec "$@"`,
-			"--", // $0 placeholder for bash -c
-		}
+		execArgs := runExecArgs(runWorkdir, scriptName, scriptArgs)
 
-		// If no extra args and no spaces, default to pnpm wrapper
-		if len(scriptArgs) == 0 {
-			execArgs = append(execArgs, "pnpm", scriptName)
+		if runInteractive {
+			err = c.ExecInteractiveWithEnv(container.ContainerSuiPlayground, runEnv, execArgs)
 		} else {
-			execArgs = append(execArgs, scriptName)
-			execArgs = append(execArgs, scriptArgs...)
+			err = c.ExecWithEnv(context.Background(), container.ContainerSuiPlayground, runEnv, execArgs)
 		}
-
-		err = c.Exec(context.Background(), container.ContainerSuiPlayground, execArgs)
 		if err != nil {
 			ui.Error.Println("Script execution failed: " + err.Error())
-			os.Exit(1)
+			// Exit with the script's own code so CI can tell failures apart.
+			os.Exit(container.ExitCode(err))
 		}
 
 		ui.Success.Println(fmt.Sprintf("Execution of '%s' completed.", scriptName))
 	},
 }
 
+// validateRunEnv checks that an --env pair is KEY=VALUE with a valid variable
+// name and a value free of shell metacharacters.
+func validateRunEnv(pair string) error {
+	key, val, ok := strings.Cut(pair, "=")
+	if !ok {
+		return fmt.Errorf("expected KEY=VALUE, got %q", pair)
+	}
+	if err := validate.EnvKey(key); err != nil {
+		return err
+	}
+	if !safeEnvValueRe.MatchString(val) {
+		return fmt.Errorf("value for %s may only contain alphanumeric characters and _ . / : @ , = + -", key)
+	}
+	return nil
+}
+
+// runExecArgs builds the container command for env run. The working
+// directory and script arguments are passed as positional parameters rather
+// than interpolated into the shell string, so they are never interpreted by
+// the shell.
+func runExecArgs(workdir, scriptName string, scriptArgs []string) []string {
+	execArgs := []string{
+		"/bin/bash", "-c",
+		`cd -- "$1" && shift && exec "$@"`,
+		"--", // $0 placeholder for bash -c
+		path.Join("/workspace", workdir),
+	}
+
+	// If no extra args and no spaces, default to pnpm wrapper
+	if len(scriptArgs) == 0 {
+		return append(execArgs, "pnpm", scriptName)
+	}
+	execArgs = append(execArgs, scriptName)
+	return append(execArgs, scriptArgs...)
+}
+
 func init() {
+	runCmd.Flags().BoolVarP(&runInteractive, "interactive", "i", false, "Attach a TTY and stdin for scripts that prompt for input")
+	runCmd.Flags().StringVar(&runWorkdir, "workdir", defaultRunWorkdir, "Directory under /workspace to run the script from (e.g. world-contracts)")
+	runCmd.Flags().StringArrayVarP(&runEnv, "env", "e", nil, "Set an environment variable for the script as KEY=VALUE (repeatable)")
 	envCmd.AddCommand(runCmd)
 }
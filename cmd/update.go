@@ -15,6 +15,7 @@ import (
 	"strings"
 	"time"
 
+	"efctl/pkg/httpclient"
 	"efctl/pkg/ui"
 
 	"github.com/spf13/cobra"
@@ -56,7 +57,7 @@ var updateCmd = &cobra.Command{
 
 		spinner, _ := ui.Spin(fmt.Sprintf("Downloading %s", binaryURL))
 
-		client := &http.Client{Timeout: updateHTTPTimeout}
+		client := httpclient.New(updateHTTPTimeout)
 		resp, err := client.Get(binaryURL) // #nosec G107 -- URL constructed from hardcoded releaseBaseURL constant
 		if err != nil {
 			if spinner != nil {
@@ -192,7 +193,7 @@ var updateCmd = &cobra.Command{
 // fetchExpectedChecksum downloads the checksums.txt file and extracts the expected SHA-256 hash
 // for the given binary name.
 func fetchExpectedChecksum(checksumsURL, binaryName string) (string, error) {
-	client := &http.Client{Timeout: 30 * time.Second}
+	client := httpclient.New(30 * time.Second)
 	resp, err := client.Get(checksumsURL) // #nosec G107 -- URL constructed from hardcoded releaseBaseURL constant
 	if err != nil {
 		return "", fmt.Errorf("failed to download checksums: %w", err)
@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"efctl/pkg/dashboard"
+	"efctl/pkg/status"
+	"efctl/pkg/sui"
+	"efctl/pkg/ui"
+
+	"github.com/spf13/cobra"
+)
+
+// callSnippet is a copy-pasteable sui CLI command with a short description.
+type callSnippet struct {
+	Title   string
+	Command string
+}
+
+var worldCallsCmd = &cobra.Command{
+	Use:   "calls",
+	Short: "Print ready-to-run sui CLI commands for the deployed world",
+	Long: `Prints copy-pasteable sui client commands pre-filled with the world package ID and
+object IDs from the workspace's extracted-object-ids.json for --network. Values that depend on
+your own objects, such as character or network node IDs, are left as <PLACEHOLDERS>.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		ids, err := status.ReadObjectIDs(workspacePath, Network)
+		if errors.Is(err, os.ErrNotExist) {
+			ui.Error.Printfln("No %s world object IDs found at %s. Run 'efctl env up' first, or pass the --network the world was deployed to.", Network, status.ObjectIDsPath(workspacePath, Network))
+			os.Exit(1)
+		}
+		if err != nil {
+			ui.Error.Printfln("Could not read the %s world object IDs: %v", Network, err)
+			os.Exit(1)
+		}
+		if ids.PackageID == "" {
+			ui.Error.Println("World package ID not found in " + status.ObjectIDsFileName + ". Run 'efctl env up' first.")
+			os.Exit(1)
+		}
+		for _, w := range ids.Warnings {
+			ui.Warn.Println(w)
+		}
+
+		for _, s := range buildCallSnippets(ids, sui.Binary()) {
+			fmt.Println("# " + s.Title)
+			fmt.Println(s.Command)
+			fmt.Println()
+		}
+	},
+}
+
+// buildCallSnippets returns commands for the sui executable bin that inspect
+// the world objects and make the world calls efctl itself makes when deploying
+// assemblies.
+func buildCallSnippets(world status.ObjectIDs, bin string) []callSnippet {
+	pkg := world.PackageID
+	snippets := []callSnippet{
+		{Title: "Inspect the world package", Command: bin + " client object " + pkg},
+	}
+
+	for _, key := range dashboard.OrderedObjectKeys(world.Objects) {
+		snippets = append(snippets, callSnippet{
+			Title:   "Inspect " + key,
+			Command: bin + " client object " + world.Objects[key] + " --json",
+		})
+	}
+
+	registry, hasRegistry := world.Objects["objectRegistry"]
+	if hasRegistry {
+		snippets = append(snippets, callSnippet{
+			Title:   "List entries in the object registry",
+			Command: bin + " client dynamic-field " + registry,
+		})
+	}
+
+	adminACL, hasACL := world.Objects["adminAcl"]
+	if hasRegistry && hasACL {
+		snippets = append(snippets, callSnippet{
+			Title: "Anchor a Smart Gate (use storage_unit or turret as the module for other assemblies)",
+			Command: strings.Join([]string{
+				bin + " client call --package " + pkg + " --module gate --function anchor",
+				"  --args " + registry + " <NETWORK_NODE_ID> <CHARACTER_ID> " + adminACL + " <ITEM_ID> <TYPE_ID> 'vector<u8>:<LOCATION_HASH>'",
+				"  --gas-budget 50000000",
+			}, " \\\n"),
+		})
+		snippets = append(snippets, callSnippet{
+			Title: "Share an anchored Smart Gate",
+			Command: strings.Join([]string{
+				bin + " client call --package " + pkg + " --module gate --function share_gate",
+				"  --args <GATE_ID> " + adminACL,
+				"  --gas-budget 10000000",
+			}, " \\\n"),
+		})
+	}
+
+	return snippets
+}
+
+func init() {
+	worldCallsCmd.Flags().StringVarP(&workspacePath, "workspace", "w", ".", "Path to the workspace directory (overrides EFCTL_WORKSPACE)")
+	worldCmd.AddCommand(worldCallsCmd)
+}
@@ -179,7 +179,7 @@ func renderWorldObject(l list.Writer, address string, repr string, jsonMap map[s
 		renderCharacter(l, address, jsonMap, repr)
 	} else {
 		displayName := deriveDisplayName(repr)
-		l.AppendItem(fmt.Sprintf("📦 %s (%s)", displayName, address))
+		l.AppendItem(fmt.Sprintf("%s %s (%s)", ui.ObjectEmoji, displayName, address))
 		l.Indent()
 		l.AppendItem(fmt.Sprintf("%s %s", styledKey("Type"), styledValue(repr)))
 		l.UnIndent()
@@ -270,7 +270,7 @@ func getMapValueString(m map[string]interface{}, key string) string {
 }
 
 func renderSSU(l list.Writer, address string, jsonMap map[string]interface{}, objMap map[string]interface{}, repr string) {
-	l.AppendItem(fmt.Sprintf("📦 Smart Storage Unit (%s)", address))
+	l.AppendItem(fmt.Sprintf("%s Smart Storage Unit (%s)", ui.ObjectEmoji, address))
 	l.Indent()
 	l.AppendItem(fmt.Sprintf("%s %s", styledKey("Type"), styledValue(repr)))
 
@@ -293,7 +293,7 @@ func renderSSU(l list.Writer, address string, jsonMap map[string]interface{}, ob
 }
 
 func renderGate(l list.Writer, address string, jsonMap map[string]interface{}, objMap map[string]interface{}, repr string) {
-	l.AppendItem(fmt.Sprintf("📦 Smart Gate (%s)", address))
+	l.AppendItem(fmt.Sprintf("%s Smart Gate (%s)", ui.ObjectEmoji, address))
 	l.Indent()
 	l.AppendItem(fmt.Sprintf("%s %s", styledKey("Type"), styledValue(repr)))
 
@@ -315,7 +315,7 @@ func renderGate(l list.Writer, address string, jsonMap map[string]interface{}, o
 }
 
 func renderTurret(l list.Writer, address string, jsonMap map[string]interface{}, objMap map[string]interface{}, repr string) {
-	l.AppendItem(fmt.Sprintf("📦 Smart Turret (%s)", address))
+	l.AppendItem(fmt.Sprintf("%s Smart Turret (%s)", ui.ObjectEmoji, address))
 	l.Indent()
 	l.AppendItem(fmt.Sprintf("%s %s", styledKey("Type"), styledValue(repr)))
 
@@ -335,7 +335,7 @@ func renderTurret(l list.Writer, address string, jsonMap map[string]interface{},
 }
 
 func renderNetworkNode(l list.Writer, address string, jsonMap map[string]interface{}, objMap map[string]interface{}, repr string) {
-	l.AppendItem(fmt.Sprintf("📦 Network Node (%s)", address))
+	l.AppendItem(fmt.Sprintf("%s Network Node (%s)", ui.ObjectEmoji, address))
 	l.Indent()
 	l.AppendItem(fmt.Sprintf("%s %s", styledKey("Type"), styledValue(repr)))
 
@@ -386,7 +386,7 @@ func renderNetworkNode(l list.Writer, address string, jsonMap map[string]interfa
 }
 
 func renderOwnerCap(l list.Writer, address string, jsonMap map[string]interface{}, repr string) {
-	l.AppendItem(fmt.Sprintf("🔑 Owner Capability (%s)", address))
+	l.AppendItem(fmt.Sprintf("%s Owner Capability (%s)", ui.KeyEmoji, address))
 	l.Indent()
 	l.AppendItem(fmt.Sprintf("%s %s", styledKey("Type"), styledValue(repr)))
 	target := getMapValueString(jsonMap, "authorized_object_id")
@@ -395,7 +395,7 @@ func renderOwnerCap(l list.Writer, address string, jsonMap map[string]interface{
 }
 
 func renderCharacter(l list.Writer, address string, jsonMap map[string]interface{}, repr string) {
-	l.AppendItem(fmt.Sprintf("👤 Character (%s)", address))
+	l.AppendItem(fmt.Sprintf("%s Character (%s)", ui.PersonEmoji, address))
 	l.Indent()
 	l.AppendItem(fmt.Sprintf("%s %s", styledKey("Type"), styledValue(repr)))
 
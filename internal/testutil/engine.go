@@ -0,0 +1,29 @@
+// Package testutil holds test helpers shared by more than one package.
+package testutil
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// FakeEngine writes a shell script that stands in for docker/podman. The
+// script records its arguments to argsFile, prints output for any command,
+// and exits with exitCode. Tests are skipped on Windows, which has no POSIX
+// shell to run it.
+func FakeEngine(t *testing.T, output string, exitCode int) (engine string, argsFile string) {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("fake engine script requires a POSIX shell")
+	}
+	dir := t.TempDir()
+	argsFile = filepath.Join(dir, "args")
+	engine = filepath.Join(dir, "engine")
+	script := fmt.Sprintf("#!/bin/sh\necho \"$@\" > %q\nprintf '%%b' %q\nexit %d\n", argsFile, output, exitCode)
+	require.NoError(t, os.WriteFile(engine, []byte(script), 0700)) // #nosec G306 -- test script must be executable
+	return engine, argsFile
+}
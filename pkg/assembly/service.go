@@ -9,6 +9,7 @@ import (
 	"regexp"
 	"strings"
 
+	"efctl/pkg/status"
 	"efctl/pkg/sui"
 	"efctl/pkg/ui"
 )
@@ -60,7 +61,7 @@ func LoadObjectIds(workspace string) (*ObjectIds, error) {
 	ids.ObjectRegistry = extracted.World.ObjectRegistry
 
 	// 2. Load from deploy.log (for character and NWN)
-	logPath := filepath.Join(workspace, "world-contracts", "deployments", "localnet", "deploy.log")
+	logPath := status.DeployLogPath(workspace)
 	file, err := os.Open(logPath) // #nosec G304 -- path constructed from known workspace prefix
 	if err == nil {
 		defer file.Close()
@@ -291,7 +292,7 @@ func extractCreatedIds(jsonOut, module string) (assemblyId, ownerCapId string, e
 func findOwnerCap(ownerAddr, assemblyId string) string {
 	// sui client objects --json
 	executor := &sui.DefaultExecutor{}
-	out, err := executor.ExecCapture("sui", "client", "objects", "--json")
+	out, err := executor.ExecCapture(sui.Binary(), "client", "objects", "--json")
 	if err != nil {
 		return ""
 	}
@@ -0,0 +1,53 @@
+// Package browser opens URLs in the user's default web browser.
+package browser
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"runtime"
+)
+
+// ErrHeadless is returned when no graphical session is available to open a browser in.
+var ErrHeadless = errors.New("no graphical session available to open a browser")
+
+// Open launches url in the default browser. It returns ErrHeadless when the
+// environment cannot display a browser so callers can print the URL instead.
+func Open(url string) error {
+	if isHeadless(runtime.GOOS, os.Getenv) {
+		return ErrHeadless
+	}
+	name, args := openCommand(runtime.GOOS, url)
+	if _, err := exec.LookPath(name); err != nil {
+		return ErrHeadless
+	}
+	return exec.Command(name, args...).Start() // #nosec G204 -- name is a fixed per-OS opener; url is passed as a single argument
+}
+
+// openCommand returns the platform opener for url. On Windows rundll32 is used
+// instead of `cmd /c start`, which would interpret "&" in query strings.
+func openCommand(goos, url string) (string, []string) {
+	switch goos {
+	case "darwin":
+		return "open", []string{url}
+	case "windows":
+		return "rundll32", []string{"url.dll,FileProtocolHandler", url}
+	default:
+		return "xdg-open", []string{url}
+	}
+}
+
+// isHeadless reports whether there is no display to open a browser on. Only
+// Linux and the BSDs need a DISPLAY or WAYLAND_DISPLAY; SSH sessions are
+// treated as headless everywhere.
+func isHeadless(goos string, getenv func(string) string) bool {
+	if getenv("SSH_CONNECTION") != "" || getenv("SSH_TTY") != "" {
+		return true
+	}
+	switch goos {
+	case "darwin", "windows":
+		return false
+	default:
+		return getenv("DISPLAY") == "" && getenv("WAYLAND_DISPLAY") == ""
+	}
+}
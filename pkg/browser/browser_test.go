@@ -0,0 +1,49 @@
+package browser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func envFrom(vars map[string]string) func(string) string {
+	return func(key string) string { return vars[key] }
+}
+
+func TestOpenCommand(t *testing.T) {
+	url := "https://example.com/?a=1&b=2"
+
+	name, args := openCommand("linux", url)
+	assert.Equal(t, "xdg-open", name)
+	assert.Equal(t, []string{url}, args)
+
+	name, args = openCommand("darwin", url)
+	assert.Equal(t, "open", name)
+	assert.Equal(t, []string{url}, args)
+
+	name, args = openCommand("windows", url)
+	assert.Equal(t, "rundll32", name)
+	assert.Equal(t, []string{"url.dll,FileProtocolHandler", url}, args)
+}
+
+func TestIsHeadless(t *testing.T) {
+	tests := []struct {
+		name string
+		goos string
+		env  map[string]string
+		want bool
+	}{
+		{"linux without display", "linux", nil, true},
+		{"linux with X11", "linux", map[string]string{"DISPLAY": ":0"}, false},
+		{"linux with wayland", "linux", map[string]string{"WAYLAND_DISPLAY": "wayland-0"}, false},
+		{"linux over ssh", "linux", map[string]string{"DISPLAY": ":0", "SSH_CONNECTION": "1.2.3.4 22"}, true},
+		{"darwin", "darwin", nil, false},
+		{"windows", "windows", nil, false},
+		{"darwin over ssh", "darwin", map[string]string{"SSH_TTY": "/dev/ttys001"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isHeadless(tt.goos, envFrom(tt.env)))
+		})
+	}
+}
@@ -1,12 +1,15 @@
 package builder
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"os"
 	"path/filepath"
 	"testing"
 
 	"efctl/pkg/config"
+	"efctl/pkg/container"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -153,6 +156,57 @@ func TestExtractPublishIDs_CaseInsensitiveConfig(t *testing.T) {
 	assert.Equal(t, "0xLOWER", cfgID)
 }
 
+func TestExtractCreatedObjects(t *testing.T) {
+	output := `build log
+{"objectChanges":[
+  {"type":"published","packageId":"0xPKG"},
+  {"type":"created","objectType":"0xPKG::config::ExtensionConfig","objectId":"0xCFG"},
+  {"type":"mutated","objectType":"0x2::coin::Coin<0x2::sui::SUI>","objectId":"0xGAS"},
+  {"type":"created","objectType":"0xPKG::config::AdminCap","objectId":"0xCAP"}
+]}`
+
+	assert.Equal(t, []CreatedObject{
+		{Type: "0xPKG::config::ExtensionConfig", ID: "0xCFG"},
+		{Type: "0xPKG::config::AdminCap", ID: "0xCAP"},
+	}, extractCreatedObjects(output))
+	assert.Nil(t, extractCreatedObjects("{bad json"))
+}
+
+// execClient stubs ExecCapture; other ContainerClient methods are unused.
+type execClient struct {
+	container.ContainerClient
+	exec func(command []string) (string, error)
+}
+
+func (c execClient) ExecCapture(_ context.Context, _ string, command []string) (string, error) {
+	return c.exec(command)
+}
+
+func TestCheckContainerPath(t *testing.T) {
+	var got []string
+	c := execClient{exec: func(command []string) (string, error) {
+		got = command
+		return "found\n", nil
+	}}
+	require.NoError(t, checkContainerPath(c, "/workspace/builder-scaffold/move-contracts/my_ext"))
+	assert.Equal(t, "/workspace/builder-scaffold/move-contracts/my_ext", got[len(got)-1])
+
+	c = execClient{exec: func([]string) (string, error) { return "missing\n", nil }}
+	err := checkContainerPath(c, "/workspace/typo")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "contract path /workspace/typo not found in container")
+}
+
+func TestCheckContainerPath_ReportsExecFailure(t *testing.T) {
+	c := execClient{exec: func([]string) (string, error) {
+		return "", errors.New("exec error: exit status 1\nError response from daemon: container is not running")
+	}}
+	err := checkContainerPath(c, "/workspace/builder-scaffold/move-contracts/my_ext")
+	require.Error(t, err)
+	assert.NotContains(t, err.Error(), "not found")
+	assert.Contains(t, err.Error(), "container is not running")
+}
+
 func TestGetLastPublishedAt(t *testing.T) {
 	pubfile := filepath.Join(t.TempDir(), "Pub.localnet.toml")
 	content := `# generated by Move
@@ -202,7 +256,13 @@ version = 0
 `), 0600))
 
 	output := `{"objectChanges":[{"type":"created","objectType":"0x::builder::ExtensionConfig","objectId":"0xCFG"}]}`
-	require.NoError(t, writePublishedIDs(workspace, output, pubfile))
+	result, err := writePublishedIDs(workspace, output, pubfile)
+	require.NoError(t, err)
+	assert.Equal(t, PublishResult{
+		BuilderPackageID:  "0xEXT",
+		ExtensionConfigID: "0xCFG",
+		CreatedObjects:    []CreatedObject{{Type: "0x::builder::ExtensionConfig", ID: "0xCFG"}},
+	}, result)
 
 	envData, err := os.ReadFile(filepath.Join(builderDir, ".env"))
 	require.NoError(t, err)
@@ -210,6 +270,68 @@ version = 0
 	assert.Contains(t, string(envData), "EXTENSION_CONFIG_ID=0xCFG")
 }
 
+// ── ListPublished ──────────────────────────────────────────────────
+
+func TestListPublished(t *testing.T) {
+	workspace := t.TempDir()
+	builderDir := filepath.Join(workspace, "builder-scaffold")
+	deployDir := filepath.Join(builderDir, "deployments", "localnet")
+	require.NoError(t, os.MkdirAll(deployDir, 0750))
+	require.NoError(t, os.WriteFile(filepath.Join(builderDir, ".env"), []byte("SUI_NETWORK=localnet\nEXTENSION_CONFIG_ID=0xCFG\nBUILDER_PACKAGE_ID=0xPKG\nGATE_BUILDER_PACKAGE_ID=\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(deployDir, "Pub.extension.toml"), []byte(`chain-id = "localnet"
+
+[[published]]
+source = { local = "/workspace/world-contracts/contracts/world" }
+published-at = "0xWORLD"
+
+[[published]]
+source = { local = "/workspace/builder-scaffold/move-contracts/smart_gate_extension" }
+published-at = "0xEXT"
+`), 0600))
+
+	entries, err := ListPublished(workspace)
+	require.NoError(t, err)
+	assert.Equal(t, []PublishedEntry{
+		{Origin: ".env", Name: "BUILDER_PACKAGE_ID", ID: "0xPKG"},
+		{Origin: ".env", Name: "EXTENSION_CONFIG_ID", ID: "0xCFG"},
+		{Origin: "deployments/localnet/Pub.extension.toml", Name: "builder-scaffold/move-contracts/smart_gate_extension", ID: "0xEXT"},
+	}, entries)
+}
+
+func TestListPublished_EmptyWorkspace(t *testing.T) {
+	entries, err := ListPublished(t.TempDir())
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestCleanPublishState(t *testing.T) {
+	workspace := t.TempDir()
+	builderDir := filepath.Join(workspace, "builder-scaffold")
+	deployDir := filepath.Join(builderDir, "deployments", "localnet")
+	require.NoError(t, os.MkdirAll(deployDir, 0750))
+	pubFile := filepath.Join(deployDir, "Pub.extension.toml")
+	require.NoError(t, os.WriteFile(pubFile, []byte("[[published]]\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(builderDir, ".env"), []byte("SUI_NETWORK=localnet\nBUILDER_PACKAGE_ID=0xPKG\nEXTENSION_CONFIG_ID=0xCFG\n"), 0600))
+
+	cleaned, err := CleanPublishState(workspace, "localnet")
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"removed deployments/localnet/Pub.extension.toml",
+		"cleared BUILDER_PACKAGE_ID in .env",
+		"cleared EXTENSION_CONFIG_ID in .env",
+	}, cleaned)
+
+	assert.NoFileExists(t, pubFile)
+	envData, err := os.ReadFile(filepath.Join(builderDir, ".env"))
+	require.NoError(t, err)
+	assert.Equal(t, "SUI_NETWORK=localnet\nBUILDER_PACKAGE_ID=\nEXTENSION_CONFIG_ID=\n", string(envData))
+
+	// A second run has nothing left to clean.
+	cleaned, err = CleanPublishState(workspace, "localnet")
+	require.NoError(t, err)
+	assert.Empty(t, cleaned)
+}
+
 // ── buildPublishCmd ────────────────────────────────────────────────
 
 func TestBuildPublishCmd_Localnet(t *testing.T) {
@@ -220,7 +342,7 @@ func TestBuildPublishCmd_Localnet(t *testing.T) {
 	pubFile := filepath.Join(pubDir, "Pub.extension.toml")
 	require.NoError(t, os.WriteFile(pubFile, []byte("old"), 0600))
 
-	cmd, _, err := buildPublishCmd(nil, tmp, "localnet", "/workspace/contracts/my_ext")
+	cmd, _, err := buildPublishCmd(nil, tmp, "localnet", "testnet", "/workspace/contracts/my_ext")
 	require.NoError(t, err)
 	assert.Contains(t, cmd, "test-publish")
 	assert.Contains(t, cmd, "/workspace/contracts/my_ext")
@@ -236,7 +358,7 @@ func TestBuildPublishCmd_LocalnetWithExistingWorldPubfile(t *testing.T) {
 	worldPubfile := filepath.Join(pubDir, "Pub.localnet.toml")
 	require.NoError(t, os.WriteFile(worldPubfile, []byte("world"), 0600))
 
-	cmd, pubfilePath, err := buildPublishCmd(nil, tmp, "localnet", "/workspace/contracts/my_ext")
+	cmd, pubfilePath, err := buildPublishCmd(nil, tmp, "localnet", "testnet", "/workspace/contracts/my_ext")
 	require.NoError(t, err)
 	assert.Contains(t, cmd, "sui client test-publish")
 	assert.Contains(t, cmd, "--pubfile-path /workspace/builder-scaffold/deployments/localnet/Pub.extension.toml")
@@ -248,84 +370,29 @@ func TestBuildPublishCmd_LocalnetWithExistingWorldPubfile(t *testing.T) {
 }
 
 func TestBuildPublishCmd_Testnet(t *testing.T) {
-	cmd, pubfilePath, err := buildPublishCmd(nil, "/ws", "testnet", "/workspace/contracts/ext")
+	cmd, pubfilePath, err := buildPublishCmd(nil, "/ws", "testnet", "testnet", "/workspace/contracts/ext")
 	require.NoError(t, err)
 	assert.Contains(t, cmd, "sui client publish")
+	assert.Contains(t, cmd, "--build-env testnet")
 	assert.Contains(t, cmd, "--json")
 	assert.Empty(t, pubfilePath)
 }
 
-func TestBuildPublishCmd_UnsupportedNetwork(t *testing.T) {
-	_, _, err := buildPublishCmd(nil, "/ws", "mainnet", "/dir")
-	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "unsupported network")
-}
-
-// ── parseDotEnv ────────────────────────────────────────────────────
-
-func TestParseDotEnv(t *testing.T) {
-	content := `# comment
-FOO=bar
-BAZ = qux
-
-# another comment
-EMPTY=
-`
-	f := filepath.Join(t.TempDir(), ".env")
-	require.NoError(t, os.WriteFile(f, []byte(content), 0600))
-
-	m, err := parseDotEnv(f)
-	require.NoError(t, err)
-	assert.Equal(t, "bar", m["FOO"])
-	assert.Equal(t, "qux", m["BAZ"])
-	assert.Equal(t, "", m["EMPTY"])
-	_, hasComment := m["# comment"]
-	assert.False(t, hasComment)
-}
-
-func TestParseDotEnv_FileNotFound(t *testing.T) {
-	_, err := parseDotEnv("/nonexistent/.env")
-	assert.Error(t, err)
-}
-
-// ── updateEnvFile ──────────────────────────────────────────────────
-
-func TestUpdateEnvFile_UpdatesExistingKeys(t *testing.T) {
-	initial := "FOO=old\nBAR=keep\n"
-	f := filepath.Join(t.TempDir(), ".env")
-	require.NoError(t, os.WriteFile(f, []byte(initial), 0600))
-
-	err := updateEnvFile(f, map[string]string{"FOO": "new"})
+func TestBuildPublishCmd_BuildEnvOverride(t *testing.T) {
+	cmd, _, err := buildPublishCmd(nil, "/ws", "testnet", "devnet", "/workspace/contracts/ext")
 	require.NoError(t, err)
+	assert.Contains(t, cmd, "--build-env devnet")
+	assert.NotContains(t, cmd, "--build-env testnet")
 
-	content, _ := os.ReadFile(f)
-	assert.Contains(t, string(content), "FOO=new")
-	assert.Contains(t, string(content), "BAR=keep")
-}
-
-func TestUpdateEnvFile_AppendsNewKeys(t *testing.T) {
-	initial := "FOO=val\n"
-	f := filepath.Join(t.TempDir(), ".env")
-	require.NoError(t, os.WriteFile(f, []byte(initial), 0600))
-
-	err := updateEnvFile(f, map[string]string{"NEW_KEY": "new_val"})
+	cmd, _, err = buildPublishCmd(nil, t.TempDir(), "localnet", "mainnet", "/workspace/contracts/ext")
 	require.NoError(t, err)
-
-	content, _ := os.ReadFile(f)
-	assert.Contains(t, string(content), "NEW_KEY=new_val")
-	assert.Contains(t, string(content), "FOO=val")
+	assert.Contains(t, cmd, "--build-env mainnet")
 }
 
-func TestUpdateEnvFile_PreservesComments(t *testing.T) {
-	initial := "# This is a comment\nFOO=old\n"
-	f := filepath.Join(t.TempDir(), ".env")
-	require.NoError(t, os.WriteFile(f, []byte(initial), 0600))
-
-	err := updateEnvFile(f, map[string]string{"FOO": "new"})
-	require.NoError(t, err)
-
-	content, _ := os.ReadFile(f)
-	assert.Contains(t, string(content), "# This is a comment")
+func TestBuildPublishCmd_UnsupportedNetwork(t *testing.T) {
+	_, _, err := buildPublishCmd(nil, "/ws", "mainnet", "testnet", "/dir")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported network")
 }
 
 // ── extractWorldPackageId ──────────────────────────────────────────
@@ -1,14 +1,14 @@
 package builder
 
 import (
-	"bufio"
 	"encoding/json"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
-	"strings"
 
+	"efctl/pkg/secrets"
+	"efctl/pkg/setup"
 	"efctl/pkg/ui"
 )
 
@@ -23,24 +23,24 @@ func InitExtensionEnv(workspace string, network string) error {
 	// mkdir -p /workspace/builder-scaffold/deployments/$NETWORK/
 	builderDeploymentsDir := filepath.Join(builderScaffoldDir, "deployments", network)
 	if err := os.RemoveAll(builderDeploymentsDir); err != nil {
-		return fmt.Errorf("failed to clean deployments dir: %w", err)
+		return fmt.Errorf("failed to clean deployments dir %s: %w", builderDeploymentsDir, err)
 	}
 	if err := os.MkdirAll(builderDeploymentsDir, 0750); err != nil { // #nosec G301
-		return fmt.Errorf("failed to create deployments dir: %w", err)
+		return fmt.Errorf("failed to create deployments dir %s: %w", builderDeploymentsDir, err)
 	}
 
 	// cp -r deployments/* /workspace/builder-scaffold/deployments/
 	// (we selectively copy the network folder for safety and test-resources.json)
 	srcDeployNetworkDir := filepath.Join(worldContractsDir, "deployments", network)
 	if err := copyDir(srcDeployNetworkDir, builderDeploymentsDir); err != nil {
-		return fmt.Errorf("failed to copy deployment network dir: %w", err)
+		return fmt.Errorf("failed to copy deployment network dir %s to %s: %w", srcDeployNetworkDir, builderDeploymentsDir, err)
 	}
 
 	// cp test-resources.json /workspace/builder-scaffold/test-resources.json
 	srcTestResources := filepath.Join(worldContractsDir, "test-resources.json")
 	dstTestResources := filepath.Join(builderScaffoldDir, "test-resources.json")
 	if err := copyFile(srcTestResources, dstTestResources); err != nil {
-		return fmt.Errorf("failed to copy test-resources.json: %w", err)
+		return fmt.Errorf("failed to copy test-resources.json from %s to %s: %w", srcTestResources, dstTestResources, err)
 	}
 
 	// Copy publication artifacts (Pub.*.toml)
@@ -79,22 +79,23 @@ func InitExtensionEnv(workspace string, network string) error {
 		ui.Debug.Println("builder-scaffold/.env already exists, skipping initial copy from .env.example")
 	} else {
 		if err := copyFile(srcEnvExample, dstEnv); err != nil {
-			return fmt.Errorf("failed to copy .env.example to .env: %w", err)
+			return fmt.Errorf("failed to copy %s to %s: %w", srcEnvExample, dstEnv, err)
 		}
 	}
 
 	// Read world-contracts/.env to fetch admin/player keys
 	worldEnvFile := filepath.Join(worldContractsDir, ".env")
-	worldEnvMap, err := parseDotEnv(worldEnvFile)
+	worldEnvMap, err := setup.ParseDotEnv(worldEnvFile)
 	if err != nil {
-		return fmt.Errorf("failed to parse world .env: %w", err)
+		return fmt.Errorf("failed to parse world .env %s: %w", worldEnvFile, err)
 	}
+	secrets.Overlay(secrets.Default, worldEnvFile, worldEnvMap)
 
 	// Read world package id
 	extractedIdsFile := filepath.Join(builderDeploymentsDir, "extracted-object-ids.json")
 	worldPackageId, err := extractWorldPackageId(extractedIdsFile)
 	if err != nil {
-		return fmt.Errorf("failed to extract world.packageId: %w", err)
+		return fmt.Errorf("failed to extract world.packageId from %s: %w", extractedIdsFile, err)
 	}
 
 	// Update builder-scaffold/.env
@@ -111,8 +112,8 @@ func InitExtensionEnv(workspace string, network string) error {
 		"SPONSOR_ADDRESSES":    worldEnvMap["SPONSOR_ADDRESSES"],
 	}
 
-	if err := updateEnvFile(dstEnv, envUpdates); err != nil {
-		return fmt.Errorf("failed to update builder-scaffold .env: %w", err)
+	if err := setup.UpdateEnvFile(dstEnv, envUpdates); err != nil {
+		return fmt.Errorf("failed to update builder-scaffold .env %s: %w", dstEnv, err)
 	}
 
 	ui.Info.Println("Configured builder-scaffold .env.")
@@ -141,7 +142,7 @@ func copyFile(src, dst string) error {
 func copyDir(src, dst string) error {
 	entries, err := os.ReadDir(src)
 	if err != nil {
-		return err
+		return fmt.Errorf("read %s: %w", src, err)
 	}
 
 	for _, entry := range entries {
@@ -150,42 +151,20 @@ func copyDir(src, dst string) error {
 
 		if entry.IsDir() {
 			if err := os.MkdirAll(dstPath, 0750); err != nil { // #nosec G301
-				return err
+				return fmt.Errorf("create %s: %w", dstPath, err)
 			}
 			if err := copyDir(srcPath, dstPath); err != nil {
 				return err
 			}
 		} else {
 			if err := copyFile(srcPath, dstPath); err != nil {
-				return err
+				return fmt.Errorf("copy %s to %s: %w", srcPath, dstPath, err)
 			}
 		}
 	}
 	return nil
 }
 
-func parseDotEnv(path string) (map[string]string, error) {
-	file, err := os.Open(path) // #nosec G304
-	if err != nil {
-		return nil, err
-	}
-	defer file.Close()
-
-	envMap := make(map[string]string)
-	scanner := bufio.NewScanner(file)
-	for scanner.Scan() {
-		line := strings.TrimSpace(scanner.Text())
-		if line == "" || strings.HasPrefix(line, "#") {
-			continue
-		}
-		parts := strings.SplitN(line, "=", 2)
-		if len(parts) == 2 {
-			envMap[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
-		}
-	}
-	return envMap, scanner.Err()
-}
-
 func extractWorldPackageId(path string) (string, error) {
 	content, err := os.ReadFile(path) // #nosec G304
 	if err != nil {
@@ -203,43 +182,3 @@ func extractWorldPackageId(path string) (string, error) {
 
 	return data.World.PackageId, nil
 }
-
-func updateEnvFile(path string, updates map[string]string) error {
-	content, err := os.ReadFile(path) // #nosec G304
-	if err != nil {
-		return err
-	}
-
-	lines := strings.Split(string(content), "\n")
-	updatedMap := make(map[string]bool)
-
-	var newLines []string
-	for _, line := range lines {
-		trimmed := strings.TrimSpace(line)
-		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
-			newLines = append(newLines, line)
-			continue
-		}
-
-		parts := strings.SplitN(trimmed, "=", 2)
-		if len(parts) == 2 {
-			key := strings.TrimSpace(parts[0])
-			if val, ok := updates[key]; ok {
-				newLines = append(newLines, fmt.Sprintf("%s=%s", key, val))
-				updatedMap[key] = true
-				continue
-			}
-		}
-		newLines = append(newLines, line)
-	}
-
-	// Append any missing keys
-	for k, v := range updates {
-		if !updatedMap[k] {
-			newLines = append(newLines, fmt.Sprintf("%s=%s", k, v))
-		}
-	}
-
-	cleanPath := filepath.Clean(path)
-	return os.WriteFile(cleanPath, []byte(strings.Join(newLines, "\n")), 0600) // #nosec G306 G703 -- path is constructed from workspace-local filepath.Join in caller
-}
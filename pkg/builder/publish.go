@@ -32,6 +32,21 @@ type objectChange struct {
 	ObjectType string `json:"objectType"`
 }
 
+// CreatedObject is an object created by a publish transaction.
+type CreatedObject struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+// PublishResult holds the IDs recovered from a publish. BuilderPackageID and
+// ExtensionConfigID are the values written to builder-scaffold/.env;
+// CreatedObjects lists every object the transaction created.
+type PublishResult struct {
+	BuilderPackageID  string          `json:"builderPackageId"`
+	ExtensionConfigID string          `json:"extensionConfigId"`
+	CreatedObjects    []CreatedObject `json:"createdObjects"`
+}
+
 type PublishSearchRoot struct {
 	HostPath      string
 	ContainerPath string
@@ -66,29 +81,34 @@ func PrepareExtensionEnv(c container.ContainerClient, workspace string, network
 }
 
 // PublishExtension publishes the custom extension to the smart assembly testnet
-// and updates the builder-scaffold/.env with the extracted package IDs.
-func PublishExtension(c container.ContainerClient, workspace string, network string, candidate PublishCandidate) error {
+// using the given Move build environment, updates the builder-scaffold/.env with
+// the extracted package IDs, and returns them.
+func PublishExtension(c container.ContainerClient, workspace, network, buildEnv string, candidate PublishCandidate) (PublishResult, error) {
+	if err := checkContainerPath(c, candidate.ContainerPath); err != nil {
+		return PublishResult{}, err
+	}
+
 	if err := PrepareExtensionEnv(c, workspace, network); err != nil {
-		return err
+		return PublishResult{}, err
 	}
 
 	ui.Info.Printf("Publishing extension contract from %s...\n", candidate.HostPath)
 
 	ui.Info.Printf("Executing publish inside container at %s...\n", candidate.ContainerPath)
 
-	publishCmd, pubfilePath, err := buildPublishCmd(c, workspace, network, candidate.ContainerPath)
+	publishCmd, pubfilePath, err := buildPublishCmd(c, workspace, network, buildEnv, candidate.ContainerPath)
 	if err != nil {
-		return err
+		return PublishResult{}, err
 	}
 
 	ui.Warn.Println("Publish logging will be piped below:")
 
 	output, err := c.ExecCapture(context.Background(), container.ContainerSuiPlayground, []string{"/bin/bash", "-c", publishCmd})
 	if output != "" {
-		fmt.Print(output)
+		fmt.Print(ui.Redact(output))
 	}
 	if err != nil {
-		return fmt.Errorf("publish command failed: %w", err)
+		return PublishResult{}, fmt.Errorf("publish command failed: %w", err)
 	}
 
 	return writePublishedIDs(workspace, output, pubfilePath)
@@ -227,7 +247,7 @@ func isExtensionManifest(manifestPath string) (bool, error) {
 
 // buildPublishCmd constructs the sui publish command and, for localnet, returns
 // the pubfile updated by test-publish so IDs can be recovered from it when needed.
-func buildPublishCmd(c container.ContainerClient, workspace, network, containerContractDir string) (string, string, error) {
+func buildPublishCmd(c container.ContainerClient, workspace, network, buildEnv, containerContractDir string) (string, string, error) {
 	switch network {
 	case "localnet":
 		// Check if we have an existing world publication file to use as a dependency.
@@ -257,28 +277,28 @@ func buildPublishCmd(c container.ContainerClient, workspace, network, containerC
 		if foundPub != "" {
 			pubFile := filepath.Join(workspace, "builder-scaffold", "deployments", network, "Pub.extension.toml")
 			if err := copyFile(foundPath, pubFile); err != nil {
-				return "", "", fmt.Errorf("failed to seed extension pubfile from %s: %w", foundPub, err)
+				return "", "", fmt.Errorf("failed to seed extension pubfile %s from %s: %w", pubFile, foundPath, err)
 			}
 			return fmt.Sprintf(
-				"cd %s && sui client test-publish --pubfile-path /workspace/builder-scaffold/deployments/localnet/Pub.extension.toml --build-env testnet --json",
-				containerContractDir,
+				"cd %s && sui client test-publish --pubfile-path /workspace/builder-scaffold/deployments/localnet/Pub.extension.toml --build-env %s --json",
+				containerContractDir, buildEnv,
 			), pubFile, nil
 		}
 
 		// Fallback to full publish if no existing world publication is found
 		pubFile := filepath.Join(workspace, "builder-scaffold", "deployments", network, "Pub.extension.toml")
 		if err := os.Remove(pubFile); err != nil && !os.IsNotExist(err) {
-			return "", "", fmt.Errorf("failed to remove previous publish file: %w", err)
+			return "", "", fmt.Errorf("failed to remove previous publish file %s: %w", pubFile, err)
 		}
 		return fmt.Sprintf(
-			"cd %s && sui client test-publish --with-unpublished-dependencies --build-env testnet --pubfile-path /workspace/builder-scaffold/deployments/localnet/Pub.extension.toml --json",
-			containerContractDir,
+			"cd %s && sui client test-publish --with-unpublished-dependencies --build-env %s --pubfile-path /workspace/builder-scaffold/deployments/localnet/Pub.extension.toml --json",
+			containerContractDir, buildEnv,
 		), pubFile, nil
 
 	case "testnet":
 		return fmt.Sprintf(
-			"cd %s && sui client publish --with-unpublished-dependencies --build-env testnet --json",
-			containerContractDir,
+			"cd %s && sui client publish --with-unpublished-dependencies --build-env %s --json",
+			containerContractDir, buildEnv,
 		), "", nil
 
 	default:
@@ -286,9 +306,9 @@ func buildPublishCmd(c container.ContainerClient, workspace, network, containerC
 	}
 }
 
-// writePublishedIDs parses the publish command JSON output and writes the discovered
-// package and config IDs into builder-scaffold/.env.
-func writePublishedIDs(workspace, output, pubfilePath string) error {
+// writePublishedIDs parses the publish command JSON output, writes the discovered
+// package and config IDs into builder-scaffold/.env, and returns them.
+func writePublishedIDs(workspace, output, pubfilePath string) (PublishResult, error) {
 	builderPackageID, extensionConfigID, parseErr := extractPublishIDs(output)
 	if parseErr != nil {
 		ui.Warn.Printf("Could not parse publish output as JSON: %v\n", parseErr)
@@ -306,9 +326,14 @@ func writePublishedIDs(workspace, output, pubfilePath string) error {
 		ui.Warn.Println("Could not automatically extract BUILDER_PACKAGE_ID. Please set it manually in builder-scaffold/.env")
 	}
 
+	result := PublishResult{
+		BuilderPackageID:  builderPackageID,
+		ExtensionConfigID: extensionConfigID,
+		CreatedObjects:    extractCreatedObjects(output),
+	}
 	if builderPackageID == "" && extensionConfigID == "" {
 		ui.Debug.Println("No published IDs found in output.")
-		return nil
+		return result, nil
 	}
 
 	updates := map[string]string{}
@@ -320,8 +345,8 @@ func writePublishedIDs(workspace, output, pubfilePath string) error {
 	}
 
 	envFile := filepath.Join(workspace, "builder-scaffold", ".env")
-	if err := updateEnvFile(envFile, updates); err != nil {
-		return fmt.Errorf("failed to update builder-scaffold/.env: %w", err)
+	if err := setup.UpdateEnvFile(envFile, updates); err != nil {
+		return result, fmt.Errorf("failed to update %s: %w", envFile, err)
 	}
 
 	if builderPackageID != "" {
@@ -330,8 +355,14 @@ func writePublishedIDs(workspace, output, pubfilePath string) error {
 	if extensionConfigID != "" {
 		ui.Info.Printf("EXTENSION_CONFIG_ID = %s\n", extensionConfigID)
 	}
+	if len(result.CreatedObjects) > 0 {
+		ui.Info.Println("Created objects:")
+		for _, obj := range result.CreatedObjects {
+			ui.Info.Printf("  %s  %s\n", obj.ID, obj.Type)
+		}
+	}
 	ui.Success.Println("builder-scaffold/.env updated with published IDs.")
-	return nil
+	return result, nil
 }
 
 // extractPublishIDs parses the JSON from `sui client publish --json` and returns
@@ -344,20 +375,9 @@ func writePublishedIDs(workspace, output, pubfilePath string) error {
 //	  { "type": "created", "objectType": "...::ExtensionConfig", "objectId": "0x..." }
 //	]
 func extractPublishIDs(output string) (builderPackageID, extensionConfigID string, err error) {
-	// The sui CLI may emit non-JSON build logs before the JSON block.
-	// Find the first '{' to locate the start of the JSON object.
-	jsonStart := strings.Index(output, "{")
-	if jsonStart == -1 {
-		return "", "", fmt.Errorf("no JSON object found in output")
-	}
-
-	var result publishOutput
-	if err := json.Unmarshal([]byte(output[jsonStart:]), &result); err != nil {
-		return "", "", fmt.Errorf("failed to unmarshal publish output: %w", err)
-	}
-
-	if result.Error != "" {
-		return "", "", fmt.Errorf("sui client error: %s", result.Error)
+	result, err := parsePublishOutput(output)
+	if err != nil {
+		return "", "", err
 	}
 
 	for _, change := range result.ObjectChanges {
@@ -389,6 +409,43 @@ func extractPublishIDs(output string) (builderPackageID, extensionConfigID strin
 	return builderPackageID, extensionConfigID, nil
 }
 
+// extractCreatedObjects returns every object created by the publish transaction,
+// in the order sui reports them. It returns nil if the output cannot be parsed.
+func extractCreatedObjects(output string) []CreatedObject {
+	result, err := parsePublishOutput(output)
+	if err != nil {
+		return nil
+	}
+
+	var created []CreatedObject
+	for _, change := range result.ObjectChanges {
+		if change.Type == "created" && change.ObjectID != "" {
+			created = append(created, CreatedObject{Type: change.ObjectType, ID: change.ObjectID})
+		}
+	}
+	return created
+}
+
+// parsePublishOutput decodes the JSON block of `sui client publish --json` output.
+func parsePublishOutput(output string) (publishOutput, error) {
+	// The sui CLI may emit non-JSON build logs before the JSON block.
+	// Find the first '{' to locate the start of the JSON object.
+	jsonStart := strings.Index(output, "{")
+	if jsonStart == -1 {
+		return publishOutput{}, fmt.Errorf("no JSON object found in output")
+	}
+
+	var result publishOutput
+	if err := json.Unmarshal([]byte(output[jsonStart:]), &result); err != nil {
+		return publishOutput{}, fmt.Errorf("failed to unmarshal publish output: %w", err)
+	}
+
+	if result.Error != "" {
+		return publishOutput{}, fmt.Errorf("sui client error: %s", result.Error)
+	}
+	return result, nil
+}
+
 // GetCandidate finds a candidate by its container path.
 func GetCandidate(workspace, containerPath string) (PublishCandidate, error) {
 	searchRoots, err := GetPublishSearchRoots(workspace)
@@ -460,6 +517,27 @@ func FindClosestMatch(workspace, target string) []string {
 	return result
 }
 
+// checkContainerPath fails fast with a readable error when the contract
+// directory is not visible inside the sui container, instead of letting the
+// publish fail with a sui CLI error buried in its output. The probe always
+// exits zero when it runs, so a failed exec (e.g. the container is not
+// running) is reported as such rather than as a missing path.
+func checkContainerPath(c container.ContainerClient, containerPath string) error {
+	out, err := c.ExecCapture(context.Background(), container.ContainerSuiPlayground,
+		[]string{"/bin/sh", "-c", `if test -d "$1"; then echo found; else echo missing; fi`, "sh", containerPath})
+	if err != nil {
+		return fmt.Errorf("failed to check contract path %s in container %s: %w", containerPath, container.ContainerSuiPlayground, err)
+	}
+	switch strings.TrimSpace(out) {
+	case "found":
+		return nil
+	case "missing":
+		return fmt.Errorf("contract path %s not found in container %s", containerPath, container.ContainerSuiPlayground)
+	default:
+		return fmt.Errorf("failed to check contract path %s in container %s: unexpected output %q", containerPath, container.ContainerSuiPlayground, out)
+	}
+}
+
 func getContainerChainID(c container.ContainerClient) (string, error) {
 	output, err := c.ExecCapture(context.Background(), container.ContainerSuiPlayground, []string{"sui", "client", "chain-identifier"})
 	if err != nil {
@@ -505,7 +583,7 @@ func repairEnvironmentIfMismatched(c container.ContainerClient, workspace, netwo
 		ui.Warn.Printf("Publication artifact chain-id mismatch (artifact: %s, container: %s).\n", bestChainID, containerChainID)
 		ui.Info.Println("Automatically redeploying world contracts to sync state...")
 
-		if err := setup.DeployWorld(c, workspace); err != nil {
+		if err := setup.DeployWorld(context.Background(), c, workspace); err != nil {
 			return fmt.Errorf("failed to redeploy world: %w", err)
 		}
 
@@ -0,0 +1,129 @@
+package builder
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"efctl/pkg/setup"
+)
+
+// PublishedEntry is a published extension ID known to the workspace, either
+// from builder-scaffold/.env or from a pubfile under builder-scaffold/deployments.
+type PublishedEntry struct {
+	// Origin is the file the entry was read from, relative to builder-scaffold.
+	Origin string
+	// Name is the .env key or, for pubfile entries, the package source path.
+	Name string
+	ID   string
+}
+
+var (
+	pubSourceRegex      = regexp.MustCompile(`source\s*=\s*\{\s*local\s*=\s*"([^"]+)"`)
+	pubPublishedAtRegex = regexp.MustCompile(`published-at\s*=\s*"([^"]+)"`)
+)
+
+// ListPublished returns the BUILDER_PACKAGE_ID and EXTENSION_CONFIG_ID entries
+// (including prefixed variants) from builder-scaffold/.env, followed by the
+// non-world packages recorded in the deployments pubfiles.
+func ListPublished(workspace string) ([]PublishedEntry, error) {
+	builderDir := filepath.Join(workspace, "builder-scaffold")
+	var entries []PublishedEntry
+
+	envMap, err := setup.ParseDotEnv(filepath.Join(builderDir, ".env"))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	var keys []string
+	for key, value := range envMap {
+		if value != "" && (strings.HasSuffix(key, "BUILDER_PACKAGE_ID") || strings.HasSuffix(key, "EXTENSION_CONFIG_ID")) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		entries = append(entries, PublishedEntry{Origin: ".env", Name: key, ID: envMap[key]})
+	}
+
+	pubfiles, err := filepath.Glob(filepath.Join(builderDir, "deployments", "*", "Pub.*.toml"))
+	if err != nil {
+		return nil, err
+	}
+	for _, pubfile := range pubfiles {
+		content, err := os.ReadFile(pubfile) // #nosec G304 -- pubfile is globbed from the workspace deployments directory
+		if err != nil {
+			return nil, err
+		}
+		origin, err := filepath.Rel(builderDir, pubfile)
+		if err != nil {
+			origin = pubfile
+		}
+		for _, pkg := range parsePubfilePackages(string(content)) {
+			entries = append(entries, PublishedEntry{Origin: filepath.ToSlash(origin), Name: pkg.Name, ID: pkg.ID})
+		}
+	}
+
+	return entries, nil
+}
+
+// parsePubfilePackages returns the source path and published-at ID of every
+// [[published]] block in a pubfile, skipping world-contracts packages.
+func parsePubfilePackages(content string) []PublishedEntry {
+	var packages []PublishedEntry
+	for _, block := range strings.Split(content, "[[published]]")[1:] {
+		source := pubSourceRegex.FindStringSubmatch(block)
+		publishedAt := pubPublishedAtRegex.FindStringSubmatch(block)
+		if source == nil || publishedAt == nil {
+			continue
+		}
+		name := strings.TrimPrefix(source[1], "/workspace/")
+		if strings.HasPrefix(name, "world-contracts/") {
+			continue
+		}
+		packages = append(packages, PublishedEntry{Name: name, ID: publishedAt[1]})
+	}
+	return packages
+}
+
+// CleanPublishState removes the ephemeral Pub.extension.toml for network and
+// clears BUILDER_PACKAGE_ID and EXTENSION_CONFIG_ID in builder-scaffold/.env so
+// the next publish starts from scratch. It returns a description of each item
+// it cleaned.
+func CleanPublishState(workspace, network string) ([]string, error) {
+	builderDir := filepath.Join(workspace, "builder-scaffold")
+	var cleaned []string
+
+	pubFile := filepath.Join(builderDir, "deployments", network, "Pub.extension.toml")
+	if err := os.Remove(pubFile); err == nil {
+		cleaned = append(cleaned, "removed deployments/"+network+"/Pub.extension.toml")
+	} else if !os.IsNotExist(err) {
+		return cleaned, fmt.Errorf("failed to remove %s: %w", pubFile, err)
+	}
+
+	envFile := filepath.Join(builderDir, ".env")
+	envMap, err := setup.ParseDotEnv(envFile)
+	if os.IsNotExist(err) {
+		return cleaned, nil
+	}
+	if err != nil {
+		return cleaned, err
+	}
+
+	updates := map[string]string{}
+	for _, key := range []string{"BUILDER_PACKAGE_ID", "EXTENSION_CONFIG_ID"} {
+		if envMap[key] != "" {
+			updates[key] = ""
+			cleaned = append(cleaned, "cleared "+key+" in .env")
+		}
+	}
+	if len(updates) == 0 {
+		return cleaned, nil
+	}
+	if err := setup.UpdateEnvFile(envFile, updates); err != nil {
+		return cleaned, fmt.Errorf("failed to update %s: %w", envFile, err)
+	}
+	return cleaned, nil
+}
@@ -3,17 +3,22 @@ package config
 import (
 	"fmt"
 	"net"
+	"net/url"
 	"os"
 	"path/filepath"
 	"regexp"
 	"strings"
 
+	"efctl/pkg/validate"
+
 	"gopkg.in/yaml.v3"
 )
 
 // safeBranchRe matches valid git branch names (alphanumeric, hyphens, underscores, dots, slashes).
 var safeBranchRe = regexp.MustCompile(`^[a-zA-Z0-9._/-]+$`)
 var safeMountIdentifierRe = regexp.MustCompile(`^[a-zA-Z0-9._-]+$`)
+var commitSHARe = regexp.MustCompile(`^[0-9a-fA-F]{7,40}$`)
+var pnpmVersionRe = regexp.MustCompile(`^[0-9]+\.[0-9]+\.[0-9]+$`)
 var safeHostnameRe = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(\.[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*$`)
 
 // AdditionalBindMount represents a user-configured host directory that should be
@@ -37,14 +42,21 @@ type Config struct {
 	WorldContractsURL     string                `yaml:"world-contracts-url"`
 	WorldContractsRef     string                `yaml:"world-contracts-ref"`
 	WorldContractsBranch  string                `yaml:"world-contracts-branch"` // Deprecated: use world-contracts-ref
+	WorldContractsCommit  string                `yaml:"world-contracts-commit"`
 	BuilderScaffoldURL    string                `yaml:"builder-scaffold-url"`
 	BuilderScaffoldRef    string                `yaml:"builder-scaffold-ref"`
 	BuilderScaffoldBranch string                `yaml:"builder-scaffold-branch"` // Deprecated: use builder-scaffold-ref
+	BuilderScaffoldCommit string                `yaml:"builder-scaffold-commit"`
 	GitAutoCRLF           *bool                 `yaml:"git-autocrlf"`
 	ContainerEngine       string                `yaml:"container-engine"`
 	AdditionalBindMounts  []AdditionalBindMount `yaml:"additional-bind-mounts"`
 	Host                  string                `yaml:"host"`
 	ExposePostgres        bool                  `yaml:"expose-postgres"`
+	PnpmVersion           string                `yaml:"pnpm-version"`
+	FrontendInstall       *bool                 `yaml:"frontend-install"`
+	WorldEnvRequired      []string              `yaml:"world-env-required"`
+	AllowedHosts          []string              `yaml:"allowed-hosts"`
+	ExplorerURLTemplate   string                `yaml:"explorer-url-template"`
 
 	// Internal field to track if a config file was actually loaded
 	configFileLoaded bool
@@ -60,6 +72,21 @@ const DefaultBuilderScaffoldURL = "https://github.com/evefrontier/builder-scaffo
 // DefaultBranch is the canonical upstream branch name when branch semantics are needed.
 const DefaultBranch = "main"
 
+// DefaultPnpmVersion is the pnpm release the frontend container runs. It must
+// be 10.26 or later so pnpm honours the allowBuilds setting efctl writes.
+const DefaultPnpmVersion = "10.26.0"
+
+// ExplorerRPCPlaceholder is replaced with the URL-encoded RPC URL when an
+// explorer link is built from explorer-url-template.
+const ExplorerRPCPlaceholder = "{rpc}"
+
+// DefaultExplorerURLTemplate opens the local network in the Suiscan explorer.
+const DefaultExplorerURLTemplate = "https://custom.suiscan.xyz/custom/home/?network=" + ExplorerRPCPlaceholder
+
+// DefaultWorldEnvRequired lists the world-contracts .env keys that must be
+// set before the world deploy runs.
+var DefaultWorldEnvRequired = []string{"ADMIN_ADDRESS", "ADMIN_PRIVATE_KEY", "SPONSOR_ADDRESSES"}
+
 // DefaultConfigFile is the default configuration file name.
 const DefaultConfigFile = "efctl.yaml"
 
@@ -112,6 +139,16 @@ func FindDefaultConfigPath(startDir string) (string, bool, error) {
 // Load reads and parses the config file at the given path.
 // If the file does not exist and the path is the default, an empty config is returned without error.
 func Load(path string) (*Config, error) {
+	return load(path, path == DefaultConfigFile)
+}
+
+// LoadRequired is like Load but treats a missing file as an error even when
+// path is the default, for config files the user named explicitly.
+func LoadRequired(path string) (*Config, error) {
+	return load(path, false)
+}
+
+func load(path string, optional bool) (*Config, error) {
 	cfg := &Config{}
 	cfg.configFileLoaded = false
 
@@ -122,22 +159,26 @@ func Load(path string) (*Config, error) {
 		cfg.configDir = filepath.Dir(cleanPath)
 	}
 	data, err := os.ReadFile(cleanPath) // #nosec G304 -- config file path is intentionally user-specified via CLI flag
-	if err != nil {
-		if os.IsNotExist(err) && path == DefaultConfigFile {
-			// Default config file is optional
-			Loaded = cfg
-			return cfg, nil
+	switch {
+	case err == nil:
+		cfg.configFileLoaded = true
+		if err := yaml.Unmarshal(data, cfg); err != nil {
+			return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
 		}
+	case os.IsNotExist(err) && optional:
+		// Default config file is optional; environment overrides still apply.
+	default:
 		return nil, fmt.Errorf("failed to read config file %s: %w", path, err)
 	}
 
-	cfg.configFileLoaded = true
-
-	if err := yaml.Unmarshal(data, cfg); err != nil {
-		return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	if err := cfg.applyEnv(os.Getenv); err != nil {
+		return nil, fmt.Errorf("config environment override: %w", err)
 	}
 
 	if err := cfg.Validate(); err != nil {
+		if !cfg.configFileLoaded {
+			return nil, fmt.Errorf("config validation error: %w", err)
+		}
 		return nil, fmt.Errorf("config validation error in %s: %w", path, err)
 	}
 
@@ -150,8 +191,13 @@ func (c *Config) Validate() error {
 	for _, validate := range []func(*Config) error{
 		validateGitURLs,
 		validateGitRefs,
+		validateGitCommits,
 		validateConfiguredHost,
 		validateAdditionalBindMounts,
+		validatePnpmVersion,
+		validateWorldEnvRequired,
+		validateAllowedHosts,
+		validateExplorerURLTemplate,
 	} {
 		if err := validate(c); err != nil {
 			return err
@@ -204,10 +250,85 @@ func validateGitRef(name, ref string) error {
 	return nil
 }
 
+func validateGitCommits(c *Config) error {
+	// Pinned commits must be plain hex SHAs (abbreviated or full)
+	for _, entry := range []struct {
+		name, sha string
+	}{
+		{"world-contracts-commit", c.WorldContractsCommit},
+		{"builder-scaffold-commit", c.BuilderScaffoldCommit},
+	} {
+		if entry.sha != "" && !commitSHARe.MatchString(entry.sha) {
+			return fmt.Errorf("%s must be a 7-40 character hex commit SHA, got: %s", entry.name, entry.sha)
+		}
+	}
+	return nil
+}
+
 func validateConfiguredHost(c *Config) error {
 	return validateHostValue("host", c.Host, c.Host != "")
 }
 
+func validateWorldEnvRequired(c *Config) error {
+	for _, key := range c.WorldEnvRequired {
+		if err := validate.EnvKey(key); err != nil {
+			return fmt.Errorf("world-env-required: %w", err)
+		}
+	}
+	return nil
+}
+
+// validateAllowedHosts rejects repository URLs whose host is not listed in
+// allowed-hosts. The effective URLs are checked, so leaving a URL unset does
+// not bypass the list via the github.com default. An empty list allows any
+// https host.
+func validateAllowedHosts(c *Config) error {
+	if len(c.AllowedHosts) == 0 {
+		return nil
+	}
+	allowed := make(map[string]bool, len(c.AllowedHosts))
+	for _, host := range c.AllowedHosts {
+		if !safeHostnameRe.MatchString(host) {
+			return fmt.Errorf("allowed-hosts contains an invalid hostname: %q", host)
+		}
+		allowed[strings.ToLower(host)] = true
+	}
+	for _, entry := range []struct {
+		name, url string
+	}{
+		{"world-contracts-url", c.GetWorldContractsURL()},
+		{"builder-scaffold-url", c.GetBuilderScaffoldURL()},
+	} {
+		u, err := url.Parse(entry.url)
+		if err != nil {
+			return fmt.Errorf("%s is not a valid URL: %w", entry.name, err)
+		}
+		if !allowed[strings.ToLower(u.Hostname())] {
+			return fmt.Errorf("%s host %q is not in allowed-hosts", entry.name, u.Hostname())
+		}
+	}
+	return nil
+}
+
+func validateExplorerURLTemplate(c *Config) error {
+	if c.ExplorerURLTemplate == "" {
+		return nil
+	}
+	u, err := url.Parse(strings.ReplaceAll(c.ExplorerURLTemplate, ExplorerRPCPlaceholder, "rpc"))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("explorer-url-template must be an http(s) URL, got: %s", c.ExplorerURLTemplate)
+	}
+	return nil
+}
+
+func validatePnpmVersion(c *Config) error {
+	// The version is interpolated into the frontend container's shell command.
+	if c.PnpmVersion != "" && !pnpmVersionRe.MatchString(c.PnpmVersion) {
+		return fmt.Errorf("pnpm-version must be an exact version such as %s, got: %s", DefaultPnpmVersion, c.PnpmVersion)
+	}
+	return nil
+}
+
 func validateAdditionalBindMounts(c *Config) error {
 	seenIdentifiers := make(map[string]struct{}, len(c.AdditionalBindMounts))
 	for index, mount := range c.AdditionalBindMounts {
@@ -368,6 +489,22 @@ func (c *Config) GetBuilderScaffoldRef() string {
 	return RecommendedBuilderScaffoldRef
 }
 
+// GetWorldContractsCommit returns the pinned world-contracts commit SHA, or empty if unset.
+func (c *Config) GetWorldContractsCommit() string {
+	if c != nil {
+		return c.WorldContractsCommit
+	}
+	return ""
+}
+
+// GetBuilderScaffoldCommit returns the pinned builder-scaffold commit SHA, or empty if unset.
+func (c *Config) GetBuilderScaffoldCommit() string {
+	if c != nil {
+		return c.BuilderScaffoldCommit
+	}
+	return ""
+}
+
 // GetGitAutoCRLF returns the configured git-autocrlf option, falling back to false.
 func (c *Config) GetGitAutoCRLF() bool {
 	if c != nil && c.GitAutoCRLF != nil {
@@ -394,6 +531,42 @@ func (c *Config) GetHost() string {
 	return "127.0.0.1"
 }
 
+// GetPnpmVersion returns the pnpm version used by the frontend container, defaulting to DefaultPnpmVersion.
+func (c *Config) GetPnpmVersion() string {
+	if c != nil && c.PnpmVersion != "" {
+		return c.PnpmVersion
+	}
+	return DefaultPnpmVersion
+}
+
+// GetFrontendInstall reports whether the frontend container always runs pnpm
+// install on start, defaulting to true.
+func (c *Config) GetFrontendInstall() bool {
+	if c != nil && c.FrontendInstall != nil {
+		return *c.FrontendInstall
+	}
+	return true
+}
+
+// GetWorldEnvRequired returns the world-contracts .env keys checked before
+// deploying, defaulting to DefaultWorldEnvRequired. An explicit empty list
+// disables the check.
+func (c *Config) GetWorldEnvRequired() []string {
+	if c != nil && c.WorldEnvRequired != nil {
+		return c.WorldEnvRequired
+	}
+	return append([]string(nil), DefaultWorldEnvRequired...)
+}
+
+// GetExplorerURLTemplate returns the explorer link template, defaulting to
+// DefaultExplorerURLTemplate.
+func (c *Config) GetExplorerURLTemplate() string {
+	if c != nil && c.ExplorerURLTemplate != "" {
+		return c.ExplorerURLTemplate
+	}
+	return DefaultExplorerURLTemplate
+}
+
 // GetPostgresHost returns the PostgreSQL bind address. PostgreSQL stays local-only
 // unless explicitly exposed, in which case it uses the validated service host.
 func (c *Config) GetPostgresHost() string {
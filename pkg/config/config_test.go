@@ -104,6 +104,35 @@ func TestValidate_AcceptsValidBranches(t *testing.T) {
 	}
 }
 
+func TestValidate_PinnedCommits(t *testing.T) {
+	tests := []struct {
+		name    string
+		sha     string
+		wantErr bool
+	}{
+		{"short sha", "a1b2c3d", false},
+		{"full sha", "a1b2c3d4e5f6a1b2c3d4e5f6a1b2c3d4e5f6a1b2", false},
+		{"uppercase", "A1B2C3D4", false},
+		{"too short", "a1b2c3", true},
+		{"too long", "a1b2c3d4e5f6a1b2c3d4e5f6a1b2c3d4e5f6a1b2c", true},
+		{"branch name", "main", true},
+		{"leading hyphen", "-a1b2c3d", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, cfg := range []*Config{{WorldContractsCommit: tt.sha}, {BuilderScaffoldCommit: tt.sha}} {
+				err := cfg.Validate()
+				if tt.wantErr {
+					assert.Error(t, err)
+				} else {
+					assert.NoError(t, err)
+				}
+			}
+		})
+	}
+}
+
 func TestValidate_AcceptsAdditionalBindMounts(t *testing.T) {
 	cfg := &Config{
 		AdditionalBindMounts: []AdditionalBindMount{{
@@ -154,6 +183,8 @@ func TestGetContainerEngine_Custom(t *testing.T) {
 func TestGetHost_Default(t *testing.T) {
 	cfg := &Config{}
 	assert.Equal(t, "127.0.0.1", cfg.GetHost())
+	assert.Empty(t, cfg.GetWorldContractsCommit())
+	assert.Empty(t, cfg.GetBuilderScaffoldCommit())
 }
 
 func TestGetHost_Custom(t *testing.T) {
@@ -206,6 +237,85 @@ func TestGetPostgresHost_UsesServiceHostWhenExposed(t *testing.T) {
 	assert.Equal(t, "devbox.local", cfg.GetPostgresHost())
 }
 
+func TestGetPnpmVersion(t *testing.T) {
+	var nilCfg *Config
+	assert.Equal(t, DefaultPnpmVersion, nilCfg.GetPnpmVersion())
+	assert.Equal(t, DefaultPnpmVersion, (&Config{}).GetPnpmVersion())
+	assert.Equal(t, "9.15.4", (&Config{PnpmVersion: "9.15.4"}).GetPnpmVersion())
+}
+
+func TestGetFrontendInstall(t *testing.T) {
+	var nilCfg *Config
+	assert.True(t, nilCfg.GetFrontendInstall())
+	assert.True(t, (&Config{}).GetFrontendInstall())
+
+	off := false
+	assert.False(t, (&Config{FrontendInstall: &off}).GetFrontendInstall())
+}
+
+func TestGetWorldEnvRequired(t *testing.T) {
+	var nilCfg *Config
+	assert.Equal(t, DefaultWorldEnvRequired, nilCfg.GetWorldEnvRequired())
+	assert.Equal(t, []string{"TENANT"}, (&Config{WorldEnvRequired: []string{"TENANT"}}).GetWorldEnvRequired())
+	assert.Empty(t, (&Config{WorldEnvRequired: []string{}}).GetWorldEnvRequired())
+}
+
+func TestValidate_RejectsInvalidWorldEnvRequired(t *testing.T) {
+	require.NoError(t, (&Config{WorldEnvRequired: []string{"SPONSOR_ADDRESSES"}}).Validate())
+	err := (&Config{WorldEnvRequired: []string{"BAD KEY"}}).Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "world-env-required")
+}
+
+func TestValidate_AllowedHosts(t *testing.T) {
+	require.NoError(t, (&Config{AllowedHosts: []string{"github.com"}}).Validate(), "defaults are on github.com")
+
+	internal := &Config{
+		AllowedHosts:       []string{"GitHub.Example.com"},
+		WorldContractsURL:  "https://github.example.com/eve/world-contracts.git",
+		BuilderScaffoldURL: "https://github.example.com/eve/builder-scaffold.git",
+	}
+	require.NoError(t, internal.Validate())
+
+	internal.BuilderScaffoldURL = ""
+	err := internal.Validate()
+	require.Error(t, err, "the default github.com URL is not allowed")
+	assert.Contains(t, err.Error(), "builder-scaffold-url host \"github.com\" is not in allowed-hosts")
+
+	err = (&Config{AllowedHosts: []string{"bad host"}}).Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "allowed-hosts")
+}
+
+func TestGetExplorerURLTemplate(t *testing.T) {
+	var nilCfg *Config
+	assert.Equal(t, DefaultExplorerURLTemplate, nilCfg.GetExplorerURLTemplate())
+	custom := "https://explorer.example.com/?rpc={rpc}"
+	assert.Equal(t, custom, (&Config{ExplorerURLTemplate: custom}).GetExplorerURLTemplate())
+}
+
+func TestValidate_ExplorerURLTemplate(t *testing.T) {
+	require.NoError(t, (&Config{ExplorerURLTemplate: "http://localhost:3000/?network={rpc}"}).Validate())
+	for _, tmpl := range []string{"explorer.example.com/{rpc}", "javascript:alert(1)", "https://"} {
+		t.Run(tmpl, func(t *testing.T) {
+			err := (&Config{ExplorerURLTemplate: tmpl}).Validate()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "explorer-url-template")
+		})
+	}
+}
+
+func TestValidate_RejectsInvalidPnpmVersion(t *testing.T) {
+	for _, version := range []string{"latest", "10", "10.26.0; rm -rf /", "^10.26.0"} {
+		t.Run(version, func(t *testing.T) {
+			err := (&Config{PnpmVersion: version}).Validate()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "pnpm-version")
+		})
+	}
+	require.NoError(t, (&Config{PnpmVersion: "10.26.0"}).Validate())
+}
+
 func TestResolveAdditionalBindMounts_UsesConfigDirectory(t *testing.T) {
 	configDir := t.TempDir()
 	mountDir := filepath.Join(configDir, "contracts")
@@ -316,6 +426,16 @@ func TestLoad_DefaultFileMissing_ReturnsEmpty(t *testing.T) {
 	assert.NotNil(t, cfg)
 }
 
+func TestLoadRequired_DefaultFileMissing_IsError(t *testing.T) {
+	origDir, _ := os.Getwd()
+	defer os.Chdir(origDir)
+	os.Chdir(t.TempDir())
+
+	_, err := LoadRequired(DefaultConfigFile)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to read config file")
+}
+
 // ── Getter defaults ────────────────────────────────────────────────
 
 func TestGetWorldContractsURL_Default(t *testing.T) {
@@ -0,0 +1,65 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// EnvPrefix is prepended to a config key to form its environment variable
+// name, e.g. world-contracts-url is read from EFCTL_WORLD_CONTRACTS_URL.
+const EnvPrefix = "EFCTL_"
+
+// EnvVarName returns the environment variable that overrides the config key.
+func EnvVarName(key string) string {
+	return EnvPrefix + strings.ToUpper(strings.ReplaceAll(key, "-", "_"))
+}
+
+// applyEnv overrides fields with any EFCTL_* environment variables that are
+// set, so the precedence is flag > env > yaml > default. Booleans accept the
+// strconv.ParseBool forms and lists are comma-separated. additional-bind-mounts
+// has no scalar form and can only be set from the file.
+func (c *Config) applyEnv(getenv func(string) string) error {
+	v := reflect.ValueOf(c).Elem()
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		key, _, _ := strings.Cut(t.Field(i).Tag.Get("yaml"), ",")
+		if key == "" || key == "-" {
+			continue
+		}
+		name := EnvVarName(key)
+		raw := getenv(name)
+		if raw == "" {
+			continue
+		}
+
+		field := v.Field(i)
+		switch field.Interface().(type) {
+		case string:
+			field.SetString(strings.TrimSpace(raw))
+		case bool, *bool:
+			b, err := strconv.ParseBool(strings.TrimSpace(raw))
+			if err != nil {
+				return fmt.Errorf("%s: invalid boolean %q", name, raw)
+			}
+			if field.Kind() == reflect.Ptr {
+				field.Set(reflect.ValueOf(&b))
+			} else {
+				field.SetBool(b)
+			}
+		case []string:
+			var items []string
+			for _, item := range strings.Split(raw, ",") {
+				if item = strings.TrimSpace(item); item != "" {
+					items = append(items, item)
+				}
+			}
+			if items == nil {
+				items = []string{}
+			}
+			field.Set(reflect.ValueOf(items))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvVarName(t *testing.T) {
+	assert.Equal(t, "EFCTL_WORLD_CONTRACTS_URL", EnvVarName("world-contracts-url"))
+	assert.Equal(t, "EFCTL_HOST", EnvVarName("host"))
+}
+
+func TestApplyEnv_OverridesFields(t *testing.T) {
+	env := map[string]string{
+		"EFCTL_WORLD_CONTRACTS_REF":  "develop",
+		"EFCTL_WITH_FRONTEND":        "false",
+		"EFCTL_EXPOSE_POSTGRES":      "true",
+		"EFCTL_WORLD_ENV_REQUIRED":   "ADMIN_ADDRESS, SPONSOR_ADDRESSES",
+		"EFCTL_BUILDER_SCAFFOLD_URL": " https://github.com/test/bs.git ",
+	}
+	cfg := &Config{WorldContractsRef: "main"}
+	require.NoError(t, cfg.applyEnv(func(k string) string { return env[k] }))
+
+	assert.Equal(t, "develop", cfg.WorldContractsRef)
+	require.NotNil(t, cfg.WithFrontend)
+	assert.False(t, *cfg.WithFrontend)
+	assert.True(t, cfg.ExposePostgres)
+	assert.Equal(t, []string{"ADMIN_ADDRESS", "SPONSOR_ADDRESSES"}, cfg.WorldEnvRequired)
+	assert.Equal(t, "https://github.com/test/bs.git", cfg.BuilderScaffoldURL)
+	assert.Nil(t, cfg.WithGraphql, "unset variables leave fields untouched")
+}
+
+func TestApplyEnv_InvalidBool(t *testing.T) {
+	cfg := &Config{}
+	err := cfg.applyEnv(func(k string) string {
+		if k == "EFCTL_WITH_GRAPHQL" {
+			return "sometimes"
+		}
+		return ""
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "EFCTL_WITH_GRAPHQL")
+}
+
+func TestLoad_EnvOverridesFileAndIsValidated(t *testing.T) {
+	old := Loaded
+	defer func() { Loaded = old }()
+
+	p := filepath.Join(t.TempDir(), "efctl.yaml")
+	require.NoError(t, os.WriteFile(p, []byte("world-contracts-ref: main\n"), 0600))
+
+	t.Setenv("EFCTL_WORLD_CONTRACTS_REF", "release/v2")
+	cfg, err := Load(p)
+	require.NoError(t, err)
+	assert.Equal(t, "release/v2", cfg.GetWorldContractsRef())
+
+	t.Setenv("EFCTL_WORLD_CONTRACTS_URL", "http://insecure.example/wc.git")
+	_, err = Load(p)
+	assert.Error(t, err)
+}
+
+func TestLoad_EnvAppliesWithoutConfigFile(t *testing.T) {
+	old := Loaded
+	defer func() { Loaded = old }()
+
+	origDir, _ := os.Getwd()
+	defer os.Chdir(origDir)
+	os.Chdir(t.TempDir())
+
+	t.Setenv("EFCTL_PNPM_VERSION", "10.27.1")
+	cfg, err := Load(DefaultConfigFile)
+	require.NoError(t, err)
+	assert.False(t, cfg.WasLoaded())
+	assert.Equal(t, "10.27.1", cfg.GetPnpmVersion())
+}
@@ -24,6 +24,8 @@ world-contracts-url: %q
 # Ref (branch, tag, or commit) to checkout for world-contracts (default: %s)
 world-contracts-ref: %q
 # world-contracts-branch: %q # Deprecated: use world-contracts-ref
+# Pin world-contracts to an exact commit SHA (7-40 hex chars); verified after checkout
+# world-contracts-commit: ""
 
 # Git clone URL for the builder-scaffold repository
 builder-scaffold-url: %q
@@ -31,6 +33,8 @@ builder-scaffold-url: %q
 # Ref (branch, tag, or commit) to checkout for builder-scaffold (default: %s)
 builder-scaffold-ref: %q
 # builder-scaffold-branch: %q # Deprecated: use builder-scaffold-ref
+# Pin builder-scaffold to an exact commit SHA (7-40 hex chars); verified after checkout
+# builder-scaffold-commit: ""
 
 # Configure Git core.autocrlf for cloned repositories (default: false)
 git-autocrlf: false
@@ -48,6 +52,29 @@ host: "127.0.0.1"
 # intentionally need remote database access; this exposes port 5432 on the host above.
 expose-postgres: false
 
+# Exact pnpm version the frontend container installs and runs (default: %s)
+# pnpm-version: %q
+
+# Set to false to skip pnpm install in the frontend container when
+# node_modules is already populated (default: true, always install)
+# frontend-install: true
+
+# world-contracts .env keys that must be set before the world deploy runs.
+# Set to [] to disable the check (default: ADMIN_ADDRESS, ADMIN_PRIVATE_KEY, SPONSOR_ADDRESSES)
+# world-env-required:
+#   - ADMIN_ADDRESS
+#   - ADMIN_PRIVATE_KEY
+#   - SPONSOR_ADDRESSES
+
+# Explorer link shown in the deployment summary and opened by env open.
+# {rpc} is replaced with the URL-encoded local RPC URL (default: %s)
+# explorer-url-template: %q
+
+# Restrict repository URLs to these hosts, e.g. an internal GitHub
+# Enterprise (default: any https host)
+# allowed-hosts:
+#   - github.example.com
+
 # Additional host directories to bind-mount into the container environment.
 # additional-bind-mounts:
 #   - hostPath: ./my-extension
@@ -55,5 +82,7 @@ expose-postgres: false
 `, DefaultWorldContractsURL,
 		RecommendedWorldContractsRef, RecommendedWorldContractsRef, RecommendedWorldContractsRef,
 		DefaultBuilderScaffoldURL,
-		RecommendedBuilderScaffoldRef, RecommendedBuilderScaffoldRef, RecommendedBuilderScaffoldRef)
+		RecommendedBuilderScaffoldRef, RecommendedBuilderScaffoldRef, RecommendedBuilderScaffoldRef,
+		DefaultPnpmVersion, DefaultPnpmVersion,
+		DefaultExplorerURLTemplate, DefaultExplorerURLTemplate)
 }
@@ -4,7 +4,9 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -12,6 +14,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"efctl/pkg/env"
@@ -69,6 +72,7 @@ type ContainerClient interface {
 	CreateContainer(ctx context.Context, cfg ContainerConfig) error
 	StartContainer(ctx context.Context, name string) error
 	StopContainer(ctx context.Context, name string) error
+	RestartContainer(ctx context.Context, name string) error
 	RemoveContainer(ctx context.Context, name string) error
 	WaitHealthy(ctx context.Context, name string, timeout time.Duration) error
 
@@ -78,12 +82,15 @@ type ContainerClient interface {
 	ContainerRunning(name string) bool
 	ContainerLogs(name string, tail int) string
 	ContainerExitCode(name string) (int, error)
+	ContainerHealth(name string) (string, error)
 	WaitForLogs(ctx context.Context, containerName string, searchString string) error
 	InteractiveShell(containerName string) error
+	ExecInteractive(containerName string, command []string) error
 	Exec(ctx context.Context, containerName string, command []string) error
+	ExecTee(ctx context.Context, containerName string, command []string, w io.Writer) error
 	ExecCapture(ctx context.Context, containerName string, command []string) (string, error)
 	RemoveImages(names []string)
-	Cleanup() error
+	Cleanup(opts CleanupOptions) error
 }
 
 // ── Client ─────────────────────────────────────────────────────────
@@ -395,6 +402,7 @@ func (c *Client) engineCommandOutput(ctx context.Context, args ...string) ([]byt
 
 func commandForEngineContext(ctx context.Context, engine string, host string, useFromEnv bool, args ...string) *exec.Cmd {
 	cmd := exec.CommandContext(ctx, engine, args...) // #nosec G204 -- arguments are constructed programmatically without shell expansion
+	ui.Command(cmd.Args[0], cmd.Args[1:]...)
 	envVars := os.Environ()
 	if host != "" {
 		envVars = withEnvVar(envVars, "DOCKER_HOST", host)
@@ -541,7 +549,25 @@ func (c *Client) NetworkName() string {
 func (c *Client) BuildImage(ctx context.Context, contextDir string, dockerfileName string, tag string) error {
 	spinner, _ := ui.Spin(fmt.Sprintf("Building image %s...", tag))
 	dockerfilePath := dockerBuildDockerfilePath(contextDir, dockerfileName)
-	output, err := c.engineCommandOutput(ctx, "build", "--no-cache", "--rm", "-t", tag, "-f", dockerfilePath, contextDir)
+
+	var output []byte
+	var err error
+	for attempt := 1; attempt <= buildAttempts; attempt++ {
+		output, err = c.engineCommandOutput(ctx, "build", "--no-cache", "--rm", "-t", tag, "-f", dockerfilePath, contextDir)
+		if err == nil || !isRetriableBuildError(string(output)) || attempt == buildAttempts {
+			break
+		}
+
+		delay := time.Duration(1<<uint(attempt)) * buildRetryBaseDelay
+		spinner.UpdateText(fmt.Sprintf("Build attempt %d hit a network error, retrying in %v...", attempt, delay))
+		select {
+		case <-ctx.Done():
+			spinner.Fail("Failed to build image")
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+		spinner.UpdateText(fmt.Sprintf("Building image %s (attempt %d/%d)...", tag, attempt+1, buildAttempts))
+	}
 	if err != nil {
 		spinner.Fail("Failed to build image")
 		return fmt.Errorf("image build: %w%s", err, trimmedCommandOutputSuffix(output))
@@ -554,6 +580,49 @@ func (c *Client) BuildImage(ctx context.Context, contextDir string, dockerfileNa
 	return nil
 }
 
+// buildAttempts bounds how often BuildImage tries a build that fails with a
+// network or registry error.
+const buildAttempts = 3
+
+// buildRetryBaseDelay is doubled per attempt between build retries; tests
+// shorten it.
+var buildRetryBaseDelay = time.Second
+
+// retriableBuildPatterns are lower-case substrings of build output that mark
+// a transient network or registry failure rather than a real build error.
+var retriableBuildPatterns = []string{
+	"could not resolve host",
+	"temporary failure in name resolution",
+	"network is unreachable",
+	"connection reset",
+	"connection refused",
+	"connection timed out",
+	"i/o timeout",
+	"tls handshake timeout",
+	"unexpected eof",
+	"toomanyrequests",
+	"502 bad gateway",
+	"503 service unavailable",
+	"504 gateway timeout",
+	"econnreset",
+	"etimedout",
+	"eai_again",
+	"err_pnpm_fetch",
+	"err_socket_timeout",
+}
+
+// isRetriableBuildError reports whether a failed build's output points at a
+// transient network or registry problem worth retrying.
+func isRetriableBuildError(output string) bool {
+	lower := strings.ToLower(output)
+	for _, pattern := range retriableBuildPatterns {
+		if strings.Contains(lower, pattern) {
+			return true
+		}
+	}
+	return false
+}
+
 func dockerBuildDockerfilePath(contextDir string, dockerfileName string) string {
 	if dockerfileName == "" || filepath.IsAbs(dockerfileName) || contextDir == "" {
 		return dockerfileName
@@ -629,6 +698,11 @@ func (c *Client) ensureContainerImage(ctx context.Context, image string) error {
 	return c.ensureImage(ctx, image)
 }
 
+// CreateCommand returns the engine command line CreateContainer runs for cfg.
+func (c *Client) CreateCommand(cfg ContainerConfig) []string {
+	return append([]string{c.Engine}, c.buildCreateContainerArgs(cfg)...)
+}
+
 func (c *Client) buildCreateContainerArgs(cfg ContainerConfig) []string {
 	args := []string{"create", "--name", cfg.Name}
 	args = append(args, c.preparePortConfig(cfg.Host, cfg.Ports)...)
@@ -807,6 +881,15 @@ func (c *Client) StopContainer(ctx context.Context, name string) error {
 	return nil
 }
 
+// RestartContainer restarts a container by name (10s stop timeout).
+func (c *Client) RestartContainer(ctx context.Context, name string) error {
+	output, err := c.engineCommandOutput(ctx, "restart", "-t", "10", name)
+	if err != nil {
+		return fmt.Errorf("restart container %s: %w%s", name, err, trimmedCommandOutputSuffix(output))
+	}
+	return nil
+}
+
 // RemoveContainer removes a container by name, ignoring "not found" errors.
 func (c *Client) RemoveContainer(ctx context.Context, name string) error {
 	output, err := c.engineCommandOutput(ctx, "rm", "-f", name)
@@ -949,6 +1032,19 @@ func (c *Client) ContainerExitCode(name string) (int, error) {
 	return info.State.ExitCode, nil
 }
 
+// ContainerHealth returns the container's native healthcheck status
+// ("starting", "healthy", or "unhealthy"), or "none" if it has no healthcheck.
+func (c *Client) ContainerHealth(name string) (string, error) {
+	info, err := c.inspectContainer(context.Background(), name)
+	if err != nil {
+		return "", err
+	}
+	if info.State == nil || info.State.Health == nil || info.State.Health.Status == "" {
+		return "none", nil
+	}
+	return info.State.Health.Status, nil
+}
+
 // WaitForLogs waits for a specific string in the container logs
 func (c *Client) WaitForLogs(ctx context.Context, containerName string, searchString string) error {
 	spinner, _ := ui.Spin(fmt.Sprintf("Waiting for %s to initialize...", containerName))
@@ -1008,58 +1104,127 @@ func (c *Client) WaitForLogs(ctx context.Context, containerName string, searchSt
 // This uses the CLI directly because the SDK exec-attach-hijack flow
 // is non-trivial for raw TTY handling, and the CLI handles it perfectly.
 func (c *Client) InteractiveShell(containerName string) error {
-	cmd := exec.Command(c.Engine, "exec", "-it", containerName, "/bin/bash") // #nosec G204
+	if err := c.ExecInteractive(containerName, []string{"/bin/bash"}); err != nil {
+		return fmt.Errorf("interactive shell error: %w", err)
+	}
+	return nil
+}
+
+// ExecInteractive runs a command inside a container with a TTY and the
+// terminal's stdin, stdout, and stderr attached, for commands that prompt.
+func (c *Client) ExecInteractive(containerName string, command []string) error {
+	return c.ExecInteractiveWithEnv(containerName, nil, command)
+}
+
+// ExecInteractiveWithEnv is ExecInteractive with extra KEY=VALUE environment
+// variables set for the command.
+func (c *Client) ExecInteractiveWithEnv(containerName string, env, command []string) error {
+	cmd := exec.Command(c.Engine, execArgs([]string{"-it"}, containerName, env, command)...) // #nosec G204
+	ui.Command(cmd.Args[0], cmd.Args[1:]...)
 	cmd.Stdin = os.Stdin
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
 
-	if err := cmd.Run(); err != nil {
-		return fmt.Errorf("interactive shell error: %w", err)
-	}
+	return cmd.Run()
+}
 
-	return nil
+// execArgs builds the engine arguments for exec. Environment variables are
+// passed with -e ahead of the container name, as the engine requires.
+func execArgs(flags []string, containerName string, env, command []string) []string {
+	args := make([]string, 0, 2+len(flags)+2*len(env)+len(command))
+	args = append(args, "exec")
+	args = append(args, flags...)
+	for _, e := range env {
+		args = append(args, "-e", e)
+	}
+	args = append(args, containerName)
+	return append(args, command...)
 }
 
-// Exec runs a command inside a container
+// Exec runs a command inside a container, streaming its stdout and stderr
+// live with private keys redacted. Use ExecCapture when the output is needed
+// as a string.
 func (c *Client) Exec(ctx context.Context, containerName string, command []string) error {
+	return c.ExecWithEnv(ctx, containerName, nil, command)
+}
+
+// ExecTee is Exec that also copies the command's redacted stdout and stderr
+// to w as they stream, for callers that need to scan the output afterwards.
+func (c *Client) ExecTee(ctx context.Context, containerName string, command []string, w io.Writer) error {
+	return c.execStream(ctx, containerName, nil, command, w)
+}
+
+// ExecWithEnv is Exec with extra KEY=VALUE environment variables set for the
+// command.
+func (c *Client) ExecWithEnv(ctx context.Context, containerName string, env, command []string) error {
+	return c.execStream(ctx, containerName, env, command, nil)
+}
+
+// execStream runs command in the container, streaming its output to the
+// terminal and, when tee is not nil, to tee as well.
+func (c *Client) execStream(ctx context.Context, containerName string, env, command []string, tee io.Writer) error {
 	spinner, _ := ui.Spin(fmt.Sprintf("Executing in %s...", containerName))
 
 	// We use the CLI for exec because it handles TTY allocation and stream
 	// multiplexing transparently.
-	args := make([]string, 0, 2+len(command))
-	args = append(args, "exec", containerName)
-	args = append(args, command...)
-	cmd := exec.CommandContext(ctx, c.Engine, args...) // #nosec G204
+	cmd := exec.CommandContext(ctx, c.Engine, execArgs(nil, containerName, env, command)...) // #nosec G204
+	ui.Command(cmd.Args[0], cmd.Args[1:]...)
 
-	output, err := cmd.CombinedOutput()
+	var outW, errW io.Writer = os.Stdout, os.Stderr
+	if tee != nil {
+		// stdout and stderr are copied by separate goroutines.
+		tee = &lockedWriter{w: tee}
+		outW, errW = io.MultiWriter(os.Stdout, tee), io.MultiWriter(os.Stderr, tee)
+	}
+	stdout := ui.NewRedactingWriter(outW)
+	stderr := ui.NewRedactingWriter(errW)
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
 
-	// Print output if any, regardless of success/fail
-	if len(output) > 0 {
-		fmt.Printf("\n%s", string(output))
+	// Stop the spinner so it does not redraw over the streamed output.
+	if spinner.IsActive {
+		_ = spinner.Stop()
 	}
+	err := cmd.Run()
+	_ = stdout.Flush()
+	_ = stderr.Flush()
 
 	if err != nil {
 		spinner.Fail("Execution failed")
 
 		// Diagnostic: list containers on failure to catch "no such container" issues
 		debugCmd := exec.Command(c.Engine, "ps", "-a") // #nosec G204
+		ui.Command(debugCmd.Args[0], debugCmd.Args[1:]...)
 		debugOut, _ := debugCmd.CombinedOutput()
 		ui.Warn.Println("Exec failed, current containers:")
-		fmt.Println(string(debugOut))
+		fmt.Println(ui.Redact(string(debugOut)))
 
-		return fmt.Errorf("exec error: %w\n%s", err, string(output))
+		return fmt.Errorf("exec error: %w", err)
 	}
 
 	spinner.Success("Execution complete")
 	return nil
 }
 
+// lockedWriter serialises writes to w.
+type lockedWriter struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func (l *lockedWriter) Write(p []byte) (int, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.w.Write(p)
+}
+
 // ExecCapture runs a command inside a container and returns the combined output.
 func (c *Client) ExecCapture(ctx context.Context, containerName string, command []string) (string, error) {
 	args := make([]string, 0, 2+len(command))
 	args = append(args, "exec", containerName)
 	args = append(args, command...)
 	cmd := exec.CommandContext(ctx, c.Engine, args...) // #nosec G204
+	ui.Command(cmd.Args[0], cmd.Args[1:]...)
 
 	output, err := cmd.CombinedOutput()
 	if err != nil {
@@ -1069,42 +1234,160 @@ func (c *Client) ExecCapture(ctx context.Context, containerName string, command
 	return string(output), nil
 }
 
+// ExitCode returns the exit code of the process behind an Exec or ExecCapture
+// error. The engine exits with the code of the command it ran, so this is the
+// script's own exit code. It returns 0 for nil and 1 when no exit code is
+// available (for example if the engine could not be started).
+func ExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && exitErr.ExitCode() > 0 {
+		return exitErr.ExitCode()
+	}
+	return 1
+}
+
 // ── Cleanup ────────────────────────────────────────────────────────
 
-// Cleanup stops/removes all efctl containers, images, networks, and volumes.
-// It also cleans up legacy compose-generated resources from older efctl versions.
-func (c *Client) Cleanup() error {
-	ctx := context.Background()
+// CleanupOptions selects what Cleanup leaves behind.
+type CleanupOptions struct {
+	// KeepFrontendModules keeps the frontend node_modules volume so the next
+	// `env up` does not reinstall dependencies from scratch.
+	KeepFrontendModules bool
+	// Scope limits Cleanup to one kind of resource. The zero value removes
+	// everything.
+	Scope CleanupScope
+}
+
+// CleanupScope selects which kind of resource Cleanup removes.
+type CleanupScope int
+
+const (
+	CleanupAll        CleanupScope = iota // containers, images, volumes, and network
+	CleanupContainers                     // containers and the network
+	CleanupImages                         // images only
+	CleanupVolumes                        // volumes only
+)
+
+// removes reports whether the scope covers resources of kind.
+func (s CleanupScope) removes(kind string) bool {
+	switch s {
+	case CleanupContainers:
+		return kind == "container" || kind == "network"
+	case CleanupImages:
+		return kind == "image"
+	case CleanupVolumes:
+		return kind == "volume"
+	default:
+		return true
+	}
+}
 
-	spinner, _ := ui.Spin("Stopping and removing sui-playground container...")
-	// Before removing the container, try to normalize permissions on bind-mounted volumes
-	// so that the host user can clean up files created by root inside the container.
-	c.normalizeBindMountPermissions(ContainerSuiPlayground)
-	c.forceRemoveContainers(ctx, []string{ContainerSuiPlayground})
-	spinner.Success(fmt.Sprintf("Container %s removal attempted", ContainerSuiPlayground))
+// Containers and images Cleanup removes, including legacy compose-era names.
+var (
+	cleanupPostgresContainers = []string{ContainerPostgres, ContainerPostgresOld, ContainerPostgresOld2}
+	cleanupFrontendContainers = []string{ContainerFrontend, ContainerFrontendOld, ContainerFrontendOld2}
+	cleanupImages             = []string{ImageSuiDev, ImageSuiDevOld, ImageSuiDevOld2}
+)
 
-	spinnerPg, _ := ui.Spin("Stopping and removing postgres container...")
-	c.forceRemoveContainers(ctx, []string{ContainerPostgres, ContainerPostgresOld, ContainerPostgresOld2})
-	spinnerPg.Success("Postgres container removal attempted")
+// CleanupTarget is one container, image, volume, or network that Cleanup
+// would remove.
+type CleanupTarget struct {
+	Kind   string // "container", "image", "volume", or "network"
+	Name   string
+	Exists bool
+}
 
-	spinnerFe, _ := ui.Spin("Stopping and removing frontend container...")
-	c.forceRemoveContainers(ctx, []string{ContainerFrontend, ContainerFrontendOld, ContainerFrontendOld2})
-	spinnerFe.Success("Frontend container removal attempted")
+// CleanupPlan lists everything Cleanup would remove for opts and whether it
+// currently exists, without removing anything.
+func (c *Client) CleanupPlan(ctx context.Context, opts CleanupOptions) []CleanupTarget {
+	var targets []CleanupTarget
+	add := func(kind string, names ...string) {
+		if !opts.Scope.removes(kind) {
+			return
+		}
+		for _, name := range names {
+			targets = append(targets, CleanupTarget{Kind: kind, Name: name, Exists: c.resourceExists(ctx, kind, name)})
+		}
+	}
+	add("container", ContainerSuiPlayground)
+	add("container", cleanupPostgresContainers...)
+	add("container", cleanupFrontendContainers...)
+	add("image", cleanupImages...)
+	add("volume", cleanupVolumes(opts)...)
+	if c.network != "" {
+		add("network", c.network)
+	}
+	return targets
+}
 
-	spinner2, _ := ui.Spin("Removing sui-dev images...")
-	c.RemoveImages([]string{ImageSuiDev, ImageSuiDevOld, ImageSuiDevOld2})
-	spinner2.Success("Images removal attempted")
+// resourceExists reports whether the engine can inspect the named container,
+// image, volume, or network.
+func (c *Client) resourceExists(ctx context.Context, kind, name string) bool {
+	_, err := c.engineCommandOutput(ctx, kind, "inspect", name)
+	return err == nil
+}
 
-	spinner3, _ := ui.Spin("Removing config and data volumes...")
-	c.removeVolumes(ctx, []string{
+// cleanupVolumes returns the volumes Cleanup removes for opts.
+func cleanupVolumes(opts CleanupOptions) []string {
+	volumes := []string{
 		VolumeSuiConfig, VolumeSuiConfigOld, VolumeSuiConfigOld2,
 		VolumePgData, VolumePgDataOld, VolumePgDataOld2,
-		VolumeFrontendMods, VolumeFrontendModsOld, VolumeFrontendModsOld2,
-	})
-	spinner3.Success("Volumes removal attempted")
+	}
+	if !opts.KeepFrontendModules {
+		volumes = append(volumes, VolumeFrontendMods, VolumeFrontendModsOld, VolumeFrontendModsOld2)
+	}
+	return volumes
+}
+
+// Cleanup stops/removes the efctl containers, images, networks, and volumes
+// covered by opts.Scope.
+// It also cleans up legacy compose-generated resources from older efctl versions.
+//
+// Teardown is by name rather than `compose down` because StartEnvironment
+// creates the containers directly; there is no compose project to bring down,
+// and running compose in builder-scaffold/docker could remove a stack the user
+// started themselves.
+func (c *Client) Cleanup(opts CleanupOptions) error {
+	ctx := context.Background()
+
+	if opts.Scope.removes("container") {
+		spinner, _ := ui.Spin("Stopping and removing sui-playground container...")
+		// Before removing the container, try to normalize permissions on bind-mounted volumes
+		// so that the host user can clean up files created by root inside the container.
+		c.normalizeBindMountPermissions(ContainerSuiPlayground)
+		c.forceRemoveContainers(ctx, []string{ContainerSuiPlayground})
+		spinner.Success(fmt.Sprintf("Container %s removal attempted", ContainerSuiPlayground))
+
+		spinnerPg, _ := ui.Spin("Stopping and removing postgres container...")
+		c.forceRemoveContainers(ctx, cleanupPostgresContainers)
+		spinnerPg.Success("Postgres container removal attempted")
+
+		spinnerFe, _ := ui.Spin("Stopping and removing frontend container...")
+		c.forceRemoveContainers(ctx, cleanupFrontendContainers)
+		spinnerFe.Success("Frontend container removal attempted")
+	}
+
+	if opts.Scope.removes("image") {
+		spinner2, _ := ui.Spin("Removing sui-dev images...")
+		c.RemoveImages(cleanupImages)
+		spinner2.Success("Images removal attempted")
+	}
+
+	if opts.Scope.removes("volume") {
+		spinner3, _ := ui.Spin("Removing config and data volumes...")
+		c.removeVolumes(ctx, cleanupVolumes(opts))
+		if opts.KeepFrontendModules {
+			spinner3.Success(fmt.Sprintf("Volumes removal attempted (kept %s)", VolumeFrontendMods))
+		} else {
+			spinner3.Success("Volumes removal attempted")
+		}
+	}
 
 	// Remove any efctl networks
-	if c.network != "" {
+	if c.network != "" && opts.Scope.removes("network") {
 		spinnerNet, _ := ui.Spin("Removing network...")
 		_ = c.RemoveNetwork(ctx, c.network)
 		spinnerNet.Success("Network removal attempted")
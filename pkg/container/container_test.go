@@ -1,13 +1,21 @@
 package container
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"fmt"
+	"io"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"reflect"
+	"runtime"
+	"strings"
 	"testing"
 	"time"
 
+	"efctl/internal/testutil"
 	"efctl/pkg/env"
 
 	"github.com/stretchr/testify/assert"
@@ -113,7 +121,7 @@ func TestServiceConfigs_SetHost(t *testing.T) {
 	assert.Equal(t, "0.0.0.0", suiCfg.Host)
 	assert.Equal(t, map[int]int{9000: 9000, 9123: 9123, 9125: 9125}, suiCfg.Ports)
 
-	frontendCfg := FrontendConfig("/workspace", "efctl-test", "docker", "0.0.0.0")
+	frontendCfg := FrontendConfig("/workspace", "efctl-test", "docker", "0.0.0.0", "10.26.0", false)
 	assert.Equal(t, "0.0.0.0", frontendCfg.Host)
 	assert.Equal(t, map[int]int{5173: 5173}, frontendCfg.Ports)
 }
@@ -125,7 +133,7 @@ func TestPostgresConfig_UsesProvidedHost(t *testing.T) {
 }
 
 func TestFrontendConfig_WorkingDir(t *testing.T) {
-	cfg := FrontendConfig("/workspace", "efctl-test", "docker", "127.0.0.1")
+	cfg := FrontendConfig("/workspace", "efctl-test", "docker", "127.0.0.1", "10.26.0", false)
 	if cfg.WorkingDir != "/workspace/builder-scaffold/dapps" {
 		t.Errorf("Expected working dir /workspace/builder-scaffold/dapps, got %q", cfg.WorkingDir)
 	}
@@ -134,6 +142,31 @@ func TestFrontendConfig_WorkingDir(t *testing.T) {
 	}
 }
 
+func TestFrontendConfig_PinsPnpmVersion(t *testing.T) {
+	cfg := FrontendConfig("/workspace", "efctl-test", "docker", "127.0.0.1", "9.15.4", false)
+	require.Len(t, cfg.Cmd, 3)
+	assert.Contains(t, cfg.Cmd[2], "npx pnpm@9.15.4 install")
+	assert.Contains(t, cfg.Cmd[2], "exec npx pnpm@9.15.4 dev")
+	assert.NotContains(t, cfg.Cmd[2], "node_modules/.pnpm")
+}
+
+func TestFrontendConfig_SkipInstallWhenPopulated(t *testing.T) {
+	cfg := FrontendConfig("/workspace", "efctl-test", "docker", "127.0.0.1", "9.15.4", true)
+	require.Len(t, cfg.Cmd, 3)
+	assert.Contains(t, cfg.Cmd[2], "if [ -d node_modules/.pnpm ]")
+	assert.Contains(t, cfg.Cmd[2], "else npx pnpm@9.15.4 install; fi")
+	assert.Contains(t, cfg.Cmd[2], "exec npx pnpm@9.15.4 dev")
+}
+
+func TestCreateCommand_PrefixesEngine(t *testing.T) {
+	c := &Client{Engine: "podman"}
+	cfg := PostgresConfig("efctl-test", "sui", "pass", "sui_indexer", "127.0.0.1")
+	args := c.CreateCommand(cfg)
+	require.GreaterOrEqual(t, len(args), 4)
+	assert.Equal(t, []string{"podman", "create", "--name", ContainerPostgres}, args[:4])
+	assert.Equal(t, c.buildCreateContainerArgs(cfg), args[1:])
+}
+
 func TestPreparePortConfig_DefaultHost(t *testing.T) {
 	c := &Client{Engine: "docker"}
 	ports := map[int]int{9000: 9000, 5432: 5432}
@@ -446,3 +479,287 @@ func TestExecHealthProbe_PodmanDetach(t *testing.T) {
 		t.Error("execHealthProbe returned false; expected true for healthy postgres")
 	}
 }
+
+// ── StopContainer / RestartContainer ───────────────────────────────
+
+func TestCleanupPlan(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("fake engine script requires a POSIX shell")
+	}
+	dir := t.TempDir()
+	engine := filepath.Join(dir, "engine")
+	script := fmt.Sprintf("#!/bin/sh\ncase \"$1 $3\" in \"container %s\"|\"volume %s\") exit 0;; esac\nexit 1\n", ContainerSuiPlayground, VolumePgData)
+	require.NoError(t, os.WriteFile(engine, []byte(script), 0700)) // #nosec G306 -- test script must be executable
+
+	c := &Client{Engine: engine, network: "efctl-test"}
+	plan := c.CleanupPlan(context.Background(), CleanupOptions{KeepFrontendModules: true})
+
+	existing := map[string]bool{}
+	for _, target := range plan {
+		assert.NotEqual(t, VolumeFrontendMods, target.Name, "kept volume must not be listed")
+		if target.Exists {
+			existing[target.Kind+" "+target.Name] = true
+		}
+	}
+	assert.Equal(t, map[string]bool{"container " + ContainerSuiPlayground: true, "volume " + VolumePgData: true}, existing)
+	assert.Equal(t, CleanupTarget{Kind: "network", Name: "efctl-test"}, plan[len(plan)-1])
+}
+
+func TestCleanupPlan_Scope(t *testing.T) {
+	engine, _ := testutil.FakeEngine(t, "", 1)
+	c := &Client{Engine: engine, network: "efctl-test"}
+
+	for scope, kinds := range map[CleanupScope][]string{
+		CleanupAll:        {"container", "image", "volume", "network"},
+		CleanupContainers: {"container", "network"},
+		CleanupImages:     {"image"},
+		CleanupVolumes:    {"volume"},
+	} {
+		seen := map[string]bool{}
+		for _, target := range c.CleanupPlan(context.Background(), CleanupOptions{Scope: scope}) {
+			seen[target.Kind] = true
+		}
+		var got []string
+		for _, kind := range []string{"container", "image", "volume", "network"} {
+			if seen[kind] {
+				got = append(got, kind)
+			}
+		}
+		assert.Equal(t, kinds, got, "scope %d", scope)
+	}
+}
+
+func TestIsRetriableBuildError(t *testing.T) {
+	assert.True(t, isRetriableBuildError("ERR_PNPM_FETCH_503  GET https://registry.npmjs.org/esbuild: Service Unavailable"))
+	assert.True(t, isRetriableBuildError("dial tcp: lookup registry-1.docker.io: Temporary failure in name resolution"))
+	assert.False(t, isRetriableBuildError("Dockerfile:12\nRUN exit 1\nERROR: process did not complete successfully: exit code: 1"))
+	assert.False(t, isRetriableBuildError("syntax error near unexpected token"))
+}
+
+func TestBuildImage_RetriesNetworkErrors(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("fake engine script requires a POSIX shell")
+	}
+	oldDelay := buildRetryBaseDelay
+	buildRetryBaseDelay = time.Millisecond
+	defer func() { buildRetryBaseDelay = oldDelay }()
+
+	for _, tc := range []struct {
+		name     string
+		output   string
+		attempts int
+	}{
+		{"network", "ERR_PNPM_FETCH_503 Service Unavailable", buildAttempts},
+		{"build", "RUN false: exit code: 1", 1},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := t.TempDir()
+			countFile := filepath.Join(dir, "count")
+			engine := filepath.Join(dir, "engine")
+			script := fmt.Sprintf("#!/bin/sh\necho x >> %q\nprintf '%%s' %q\nexit 1\n", countFile, tc.output)
+			require.NoError(t, os.WriteFile(engine, []byte(script), 0700)) // #nosec G306 -- test script must be executable
+
+			c := &Client{Engine: engine}
+			err := c.BuildImage(context.Background(), dir, "Dockerfile", "efctl-test:latest")
+			require.Error(t, err)
+			count, readErr := os.ReadFile(countFile) // #nosec G304 -- test temp file
+			require.NoError(t, readErr)
+			assert.Equal(t, tc.attempts, strings.Count(string(count), "x"))
+		})
+	}
+}
+
+func TestStopContainer(t *testing.T) {
+	engine, argsFile := testutil.FakeEngine(t, "", 0)
+	c := &Client{Engine: engine}
+
+	require.NoError(t, c.StopContainer(context.Background(), ContainerFrontend))
+	args, err := os.ReadFile(argsFile) // #nosec G304 -- test temp file
+	require.NoError(t, err)
+	assert.Equal(t, "stop -t 10 efctl-frontend\n", string(args))
+}
+
+func TestStopContainer_IgnoresMissingContainer(t *testing.T) {
+	engine, _ := testutil.FakeEngine(t, "Error: No such container: efctl-frontend", 1)
+	c := &Client{Engine: engine}
+
+	assert.NoError(t, c.StopContainer(context.Background(), ContainerFrontend))
+}
+
+func TestRestartContainer(t *testing.T) {
+	engine, argsFile := testutil.FakeEngine(t, "", 0)
+	c := &Client{Engine: engine}
+
+	require.NoError(t, c.RestartContainer(context.Background(), ContainerPostgres))
+	args, err := os.ReadFile(argsFile) // #nosec G304 -- test temp file
+	require.NoError(t, err)
+	assert.Equal(t, "restart -t 10 efctl-postgres\n", string(args))
+}
+
+func TestRestartContainer_ReportsMissingContainer(t *testing.T) {
+	engine, _ := testutil.FakeEngine(t, "Error: No such container: efctl-postgres", 1)
+	c := &Client{Engine: engine}
+
+	err := c.RestartContainer(context.Background(), ContainerPostgres)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "restart container efctl-postgres")
+	assert.Contains(t, err.Error(), "No such container")
+}
+
+func TestSuiDevConfig_RPCHealthcheck(t *testing.T) {
+	cfg := SuiDevConfig("/ws", "net", "docker", false, "u", "p", "d", nil, "")
+	require.NotNil(t, cfg.Healthcheck)
+	assert.Equal(t, "CMD-SHELL", cfg.Healthcheck.Test[0])
+	assert.Contains(t, cfg.Healthcheck.Test[1], "http://127.0.0.1:9000")
+}
+
+func TestContainerHealth(t *testing.T) {
+	engine, argsFile := testutil.FakeEngine(t, `[{"State":{"Running":true,"Health":{"Status":"healthy"}}}]`, 0)
+	c := &Client{Engine: engine}
+
+	status, err := c.ContainerHealth(ContainerSuiPlayground)
+	require.NoError(t, err)
+	assert.Equal(t, "healthy", status)
+	args, err := os.ReadFile(argsFile) // #nosec G304 -- test temp file
+	require.NoError(t, err)
+	assert.Equal(t, "container inspect sui-playground\n", string(args))
+}
+
+func TestContainerHealth_NoHealthcheck(t *testing.T) {
+	engine, _ := testutil.FakeEngine(t, `[{"State":{"Running":true}}]`, 0)
+	c := &Client{Engine: engine}
+
+	status, err := c.ContainerHealth(ContainerSuiPlayground)
+	require.NoError(t, err)
+	assert.Equal(t, "none", status)
+}
+
+// ── ContainerRunning / ContainerExitCode ───────────────────────────
+
+func TestContainerRunning(t *testing.T) {
+	engine, argsFile := testutil.FakeEngine(t, `[{"State":{"Running":true}}]`, 0)
+	c := &Client{Engine: engine}
+	assert.True(t, c.ContainerRunning(ContainerPostgres))
+	args, err := os.ReadFile(argsFile) // #nosec G304 -- test temp file
+	require.NoError(t, err)
+	assert.Equal(t, "container inspect efctl-postgres\n", string(args))
+
+	engine, _ = testutil.FakeEngine(t, `[{"State":{"Running":false,"ExitCode":1}}]`, 0)
+	assert.False(t, (&Client{Engine: engine}).ContainerRunning(ContainerPostgres))
+
+	engine, _ = testutil.FakeEngine(t, "Error: No such container: efctl-postgres", 1)
+	assert.False(t, (&Client{Engine: engine}).ContainerRunning(ContainerPostgres))
+}
+
+func TestContainerExitCode(t *testing.T) {
+	engine, _ := testutil.FakeEngine(t, `[{"State":{"Running":false,"ExitCode":137}}]`, 0)
+	code, err := (&Client{Engine: engine}).ContainerExitCode(ContainerFrontend)
+	require.NoError(t, err)
+	assert.Equal(t, 137, code)
+}
+
+func TestContainerExitCode_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		output   string
+		exitCode int
+		want     string
+	}{
+		{"inspect fails", "Error: No such container: efctl-frontend", 1, "No such container"},
+		{"malformed json", "not json", 0, "decode container inspect"},
+		{"no results", "[]", 0, "returned no results"},
+		{"no state", "[{}]", 0, "no state for container"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine, _ := testutil.FakeEngine(t, tt.output, tt.exitCode)
+			code, err := (&Client{Engine: engine}).ContainerExitCode(ContainerFrontend)
+			require.Error(t, err)
+			assert.Equal(t, -1, code)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
+
+// ── ExitCode ───────────────────────────────────────────────────────
+
+func TestExitCode_PropagatesExecExitCode(t *testing.T) {
+	engine, _ := testutil.FakeEngine(t, "2 tests failed", 2)
+	c := &Client{Engine: engine}
+
+	_, err := c.ExecCapture(context.Background(), ContainerSuiPlayground, []string{"pnpm", "test"})
+	require.Error(t, err)
+	assert.Equal(t, 2, ExitCode(err))
+}
+
+func TestExitCode_Defaults(t *testing.T) {
+	assert.Equal(t, 0, ExitCode(nil))
+	assert.Equal(t, 1, ExitCode(errors.New("engine not found")))
+}
+
+// ── Exec ───────────────────────────────────────────────────────────
+
+func TestExec_StreamsRedactedOutput(t *testing.T) {
+	engine, _ := testutil.FakeEngine(t, "key suiprivkey1qabcdef\ndone\n", 3)
+	c := &Client{Engine: engine}
+
+	r, w, err := os.Pipe()
+	require.NoError(t, err)
+	oldStdout := os.Stdout
+	os.Stdout = w
+	execErr := c.Exec(context.Background(), ContainerSuiPlayground, []string{"pnpm", "test"})
+	os.Stdout = oldStdout
+	require.NoError(t, w.Close())
+	out, err := io.ReadAll(r)
+	require.NoError(t, err)
+
+	require.Error(t, execErr)
+	assert.Equal(t, 3, ExitCode(execErr))
+	assert.Contains(t, string(out), "key suiprivkey[REDACTED]\ndone\n")
+	assert.NotContains(t, string(out), "suiprivkey1qabcdef")
+}
+
+func TestExecTee_CopiesRedactedOutput(t *testing.T) {
+	engine, _ := testutil.FakeEngine(t, "key suiprivkey1qabcdef\nSPONSOR_ADDRESSES is required\n", 1)
+	c := &Client{Engine: engine}
+
+	var buf bytes.Buffer
+	err := c.ExecTee(context.Background(), ContainerSuiPlayground, []string{"pnpm", "deploy-world"}, &buf)
+	require.Error(t, err)
+	assert.Equal(t, "key suiprivkey[REDACTED]\nSPONSOR_ADDRESSES is required\n", buf.String())
+}
+
+func TestExecInteractive(t *testing.T) {
+	engine, argsFile := testutil.FakeEngine(t, "", 4)
+	c := &Client{Engine: engine}
+
+	err := c.ExecInteractive(ContainerSuiPlayground, []string{"pnpm", "setup"})
+	require.Error(t, err)
+	assert.Equal(t, 4, ExitCode(err))
+	args, readErr := os.ReadFile(argsFile) // #nosec G304 -- test temp file
+	require.NoError(t, readErr)
+	assert.Equal(t, "exec -it sui-playground pnpm setup\n", string(args))
+}
+
+func TestExecWithEnv_PassesEnvBeforeContainer(t *testing.T) {
+	engine, argsFile := testutil.FakeEngine(t, "", 0)
+	c := &Client{Engine: engine}
+
+	err := c.ExecWithEnv(context.Background(), ContainerSuiPlayground, []string{"LOG_LEVEL=debug", "CI=1"}, []string{"pnpm", "test"})
+	require.NoError(t, err)
+	args, readErr := os.ReadFile(argsFile) // #nosec G304 -- test temp file
+	require.NoError(t, readErr)
+	assert.Equal(t, "exec -e LOG_LEVEL=debug -e CI=1 sui-playground pnpm test\n", string(args))
+}
+
+func TestCleanupVolumes_KeepFrontendModules(t *testing.T) {
+	all := cleanupVolumes(CleanupOptions{})
+	assert.Contains(t, all, VolumeFrontendMods)
+	assert.Contains(t, all, VolumePgData)
+
+	kept := cleanupVolumes(CleanupOptions{KeepFrontendModules: true})
+	assert.NotContains(t, kept, VolumeFrontendMods)
+	assert.NotContains(t, kept, VolumeFrontendModsOld)
+	assert.Contains(t, kept, VolumeSuiConfig)
+	assert.Contains(t, kept, VolumePgData)
+}
@@ -13,6 +13,10 @@ type AdditionalBindMount struct {
 	Identifier string
 }
 
+// suiRPCHealthcheck probes the JSON-RPC endpoint from inside the container.
+const suiRPCHealthcheck = `curl -sf --max-time 5 -X POST -H 'Content-Type: application/json' ` +
+	`-d '{"jsonrpc":"2.0","id":1,"method":"sui_getChainIdentifier","params":[]}' http://127.0.0.1:9000 >/dev/null`
+
 // SuiDevConfig returns the ContainerConfig for the main Sui development node.
 func SuiDevConfig(workspace, networkName, engine string, withGraphql bool, pgUser, pgPass, pgDB string, additionalMounts []AdditionalBindMount, host string) ContainerConfig {
 	builderScaffold := filepath.Join(workspace, "builder-scaffold")
@@ -53,8 +57,15 @@ func SuiDevConfig(workspace, networkName, engine string, withGraphql bool, pgUse
 		Aliases:     []string{"sui-dev", ContainerSuiPlayground},
 		Tty:         true,
 		OpenStdin:   true,
-		UsernsMode:  usernsMode,
-		Host:        host,
+		Healthcheck: &HealthcheckDef{
+			Test:        []string{"CMD-SHELL", suiRPCHealthcheck},
+			Interval:    5 * time.Second,
+			Timeout:     6 * time.Second,
+			Retries:     60,
+			StartPeriod: 30 * time.Second,
+		},
+		UsernsMode: usernsMode,
+		Host:       host,
 	}
 }
 
@@ -97,7 +108,10 @@ func PostgresConfig(networkName, user, password, dbName, host string) ContainerC
 	}
 }
 
-func FrontendConfig(workspace, networkName, engine, host string) ContainerConfig {
+// FrontendConfig returns the ContainerConfig for the builder-scaffold Vite dev
+// server, running the given exact pnpm version. With skipInstall set, pnpm
+// install only runs when node_modules has not been populated yet.
+func FrontendConfig(workspace, networkName, engine, host, pnpmVersion string, skipInstall bool) ContainerConfig {
 	usernsMode := ""
 	if engine == "podman" {
 		usernsMode = "keep-id"
@@ -114,8 +128,17 @@ func FrontendConfig(workspace, networkName, engine, host string) ContainerConfig
 		NetworkName: networkName,
 		Aliases:     []string{"frontend"},
 		WorkingDir:  "/workspace/builder-scaffold/dapps",
-		Cmd:         []string{"sh", "-c", "set -e\nnpx pnpm install\nexec npx pnpm dev --host 0.0.0.0"},
+		Cmd:         []string{"sh", "-c", frontendScript(pnpmVersion, skipInstall)},
 		UsernsMode:  usernsMode,
 		Host:        host,
 	}
 }
+
+// frontendScript builds the shell script the frontend container runs.
+func frontendScript(pnpmVersion string, skipInstall bool) string {
+	install := fmt.Sprintf("npx pnpm@%s install", pnpmVersion)
+	if skipInstall {
+		install = fmt.Sprintf("if [ -d node_modules/.pnpm ]; then echo 'node_modules already populated; skipping pnpm install'; else %s; fi", install)
+	}
+	return fmt.Sprintf("set -e\n%s\nexec npx pnpm@%s dev --host 0.0.0.0", install, pnpmVersion)
+}
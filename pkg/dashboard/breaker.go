@@ -0,0 +1,63 @@
+package dashboard
+
+import "time"
+
+// DefaultRPCBreakerThreshold is the number of consecutive RPC timeouts after
+// which the dashboard treats the node as unresponsive.
+const DefaultRPCBreakerThreshold = 3
+
+// DefaultRPCMaxBackoff caps how long the dashboard waits between chain polls
+// while the node is unresponsive.
+const DefaultRPCMaxBackoff = 30 * time.Second
+
+// RPCBreaker is a circuit breaker for chain polling. A node that accepts the
+// connection and then stalls makes every call wait for the full timeout, so
+// after Threshold consecutive timeouts the breaker opens and polls are spaced
+// out with an exponential backoff until the node answers again.
+type RPCBreaker struct {
+	Threshold  int
+	MaxBackoff time.Duration
+
+	timeouts int
+	retryAt  time.Time
+}
+
+// NewRPCBreaker returns a breaker with the default threshold and backoff cap.
+func NewRPCBreaker() RPCBreaker {
+	return RPCBreaker{Threshold: DefaultRPCBreakerThreshold, MaxBackoff: DefaultRPCMaxBackoff}
+}
+
+// Open reports whether the node is currently considered unresponsive.
+func (b RPCBreaker) Open() bool {
+	return b.Threshold > 0 && b.timeouts >= b.Threshold
+}
+
+// ShouldPoll reports whether the chain should be polled at now. It is always
+// true while the breaker is closed.
+func (b RPCBreaker) ShouldPoll(now time.Time) bool {
+	return !b.Open() || !now.Before(b.retryAt)
+}
+
+// Record updates the breaker with the outcome of a poll made at now. Any
+// answer from the node, including an error response, closes the breaker;
+// interval is the normal refresh interval the backoff grows from.
+func (b *RPCBreaker) Record(timedOut bool, now time.Time, interval time.Duration) {
+	if !timedOut {
+		b.timeouts = 0
+		b.retryAt = time.Time{}
+		return
+	}
+	b.timeouts++
+	if !b.Open() {
+		return
+	}
+
+	backoff := interval
+	for i := b.Threshold; i < b.timeouts && backoff < b.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if b.MaxBackoff > 0 && backoff > b.MaxBackoff {
+		backoff = b.MaxBackoff
+	}
+	b.retryAt = now.Add(backoff)
+}
@@ -0,0 +1,53 @@
+package dashboard
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRPCBreaker_OpensAfterThreshold(t *testing.T) {
+	b := NewRPCBreaker()
+	now := time.Unix(0, 0)
+
+	for i := 0; i < DefaultRPCBreakerThreshold-1; i++ {
+		b.Record(true, now, 2*time.Second)
+		assert.False(t, b.Open())
+		assert.True(t, b.ShouldPoll(now))
+	}
+
+	b.Record(true, now, 2*time.Second)
+	assert.True(t, b.Open())
+	assert.False(t, b.ShouldPoll(now.Add(time.Second)))
+	assert.True(t, b.ShouldPoll(now.Add(2*time.Second)))
+}
+
+func TestRPCBreaker_BackoffDoublesUpToCap(t *testing.T) {
+	b := RPCBreaker{Threshold: 1, MaxBackoff: 10 * time.Second}
+	now := time.Unix(0, 0)
+
+	b.Record(true, now, 2*time.Second)
+	assert.Equal(t, now.Add(2*time.Second), b.retryAt)
+
+	b.Record(true, now, 2*time.Second)
+	assert.Equal(t, now.Add(4*time.Second), b.retryAt)
+
+	b.Record(true, now, 2*time.Second)
+	assert.Equal(t, now.Add(8*time.Second), b.retryAt)
+
+	b.Record(true, now, 2*time.Second)
+	assert.Equal(t, now.Add(10*time.Second), b.retryAt)
+}
+
+func TestRPCBreaker_ClosesOnAnswer(t *testing.T) {
+	b := RPCBreaker{Threshold: 1, MaxBackoff: time.Minute}
+	now := time.Unix(0, 0)
+
+	b.Record(true, now, time.Second)
+	assert.True(t, b.Open())
+
+	b.Record(false, now, time.Second)
+	assert.False(t, b.Open())
+	assert.True(t, b.ShouldPoll(now))
+}
@@ -5,9 +5,12 @@ package dashboard
 
 import (
 	"fmt"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
+	"unicode"
 
 	"github.com/charmbracelet/lipgloss"
 )
@@ -103,8 +106,50 @@ func FormatGas(computation, storage, rebate string) string {
 	return FormatWithCommas(strconv.FormatInt(total, 10))
 }
 
-// ColorizeLogLine applies colour to log line prefixes.
+// LogTimestampLayout is the local time format --log-timestamps prepends to
+// dashboard log lines.
+const LogTimestampLayout = "15:04:05"
+
+// StampLogLine prefixes line with now in LogTimestampLayout.
+func StampLogLine(line string, now time.Time) string {
+	return now.Format(LogTimestampLayout) + " " + line
+}
+
+// terminalEscapeRe matches ANSI escape sequences: CSI (colours, cursor
+// movement), OSC (window titles, hyperlinks), and two-byte escapes.
+var terminalEscapeRe = regexp.MustCompile(`\x1b(?:\[[0-?]*[ -/]*[@-~]|\][^\x07\x1b]*(?:\x07|\x1b\\)|[@-Z\\-_])`)
+
+// StripANSI removes terminal escape sequences and control characters other
+// than tab from a log line, so the dashboard alone decides colours and
+// lipgloss.Width measures what is actually drawn. Container output such as
+// the frontend's pnpm and vite logs is often coloured, and progress lines are
+// redrawn with \r; only the last redraw is kept, as a terminal would show.
+func StripANSI(line string) string {
+	line = terminalEscapeRe.ReplaceAllString(line, "")
+	line = strings.TrimRight(line, "\r")
+	if i := strings.LastIndexByte(line, '\r'); i >= 0 {
+		line = line[i+1:]
+	}
+	return strings.Map(func(r rune) rune {
+		if r != '\t' && unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, line)
+}
+
+// ColorizeLogLine applies colour to log line prefixes, dimming a leading
+// timestamp added by StampLogLine.
 func ColorizeLogLine(line string) string {
+	if len(line) > len(LogTimestampLayout) && line[len(LogTimestampLayout)] == ' ' {
+		if _, err := time.Parse(LogTimestampLayout, line[:len(LogTimestampLayout)]); err == nil {
+			return lipgloss.NewStyle().Foreground(Gray).Render(line[:len(LogTimestampLayout)]) + " " + colorizeLogSource(line[len(LogTimestampLayout)+1:])
+		}
+	}
+	return colorizeLogSource(line)
+}
+
+func colorizeLogSource(line string) string {
 	if strings.HasPrefix(line, "[docker]") {
 		return lipgloss.NewStyle().Foreground(Cyan).Render("[docker]") + line[8:]
 	}
@@ -120,6 +165,31 @@ func ColorizeLogLine(line string) string {
 	return line
 }
 
+// knownWorldObjectKeys lists the core world objects in their display order.
+var knownWorldObjectKeys = []string{"governorCap", "adminAcl", "objectRegistry", "serverAddressRegistry", "energyConfig", "fuelConfig", "gateConfig"}
+
+// OrderedObjectKeys returns the keys of objs with the known world objects
+// first, followed by any other keys sorted alphabetically, so the rendering
+// order is stable between refreshes.
+func OrderedObjectKeys(objs map[string]string) []string {
+	keys := make([]string, 0, len(objs))
+	known := make(map[string]bool, len(knownWorldObjectKeys))
+	for _, k := range knownWorldObjectKeys {
+		known[k] = true
+		if _, ok := objs[k]; ok {
+			keys = append(keys, k)
+		}
+	}
+	var extra []string
+	for k := range objs {
+		if !known[k] {
+			extra = append(extra, k)
+		}
+	}
+	sort.Strings(extra)
+	return append(keys, extra...)
+}
+
 // HumanizeCamelCase converts "governorCap" to "Governor Cap", etc.
 func HumanizeCamelCase(s string) string {
 	if s == "" {
@@ -143,6 +143,56 @@ func TestColorizeLogLine(t *testing.T) {
 	}
 }
 
+func TestStampLogLine(t *testing.T) {
+	now := time.Date(2026, 1, 2, 13, 4, 5, 0, time.Local)
+	assert.Equal(t, "13:04:05 [db] ready", StampLogLine("[db] ready", now))
+}
+
+func TestStripANSI(t *testing.T) {
+	tests := []struct {
+		name, input, want string
+	}{
+		{"plain", "ready in 312 ms", "ready in 312 ms"},
+		{"sgr colours", "\x1b[32m✓\x1b[0m \x1b[1;36mready\x1b[39m", "✓ ready"},
+		{"cursor movement", "\x1b[2K\x1b[1Gprogress 50%", "progress 50%"},
+		{"osc hyperlink", "see \x1b]8;;http://localhost:5173\x1b\\link\x1b]8;;\x07 now", "see link now"},
+		{"progress redraw", "Progress: resolved 1\rProgress: resolved 2", "Progress: resolved 2"},
+		{"crlf", "done\r", "done"},
+		{"bell", "beep\a", "beep"},
+		{"keeps tabs", "a\tb", "a\tb"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, StripANSI(tt.input))
+		})
+	}
+}
+
+func TestColorizeLogLine_Timestamped(t *testing.T) {
+	result := ColorizeLogLine("13:04:05 [docker] container started")
+	assert.Contains(t, result, "13:04:05")
+	assert.Contains(t, result, "[docker]")
+	assert.Contains(t, result, " container started")
+
+	// Lines that only look like a timestamp pass through unchanged.
+	assert.Equal(t, "13:04:5x something", ColorizeLogLine("13:04:5x something"))
+}
+
+func TestOrderedObjectKeys(t *testing.T) {
+	objs := map[string]string{
+		"zeta":           "0x6",
+		"objectRegistry": "0x3",
+		"alpha":          "0x5",
+		"governorCap":    "0x1",
+		"mid":            "0x7",
+	}
+	want := []string{"governorCap", "objectRegistry", "alpha", "mid", "zeta"}
+	for i := 0; i < 20; i++ {
+		assert.Equal(t, want, OrderedObjectKeys(objs))
+	}
+	assert.Empty(t, OrderedObjectKeys(nil))
+}
+
 func TestHumanizeCamelCase(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -156,6 +156,55 @@ type Report struct {
 	Config    ConfigInfo
 }
 
+// Check is the pass/fail outcome of one prerequisite, shared by the doctor
+// table and JSON renderers.
+type Check struct {
+	Name   string `json:"check"`
+	OK     bool   `json:"ok"`
+	Detail string `json:"detail"`
+}
+
+// Checks derives the pass/fail prerequisite checks from the report. Ports
+// in use count as passing while the environment is up, since efctl's own
+// containers hold them.
+func (r *Report) Checks() []Check {
+	checks := []Check{
+		toolCheck("container-runtime", r.Container.Found, strings.TrimSpace(r.Container.Engine+" "+r.Container.Version)),
+		toolCheck("node", r.Node.Found, r.Node.Version),
+		toolCheck("git", r.Git.Found, r.Git.Version),
+	}
+	envRunning := r.Env.State == "up" || r.Env.State == "partial"
+	for _, p := range r.Ports {
+		detail := "free"
+		if !p.Available {
+			detail = "in use"
+		}
+		checks = append(checks, Check{
+			Name:   fmt.Sprintf("port-%d", p.Port),
+			OK:     p.Available || envRunning,
+			Detail: detail,
+		})
+	}
+	return checks
+}
+
+func toolCheck(name string, found bool, version string) Check {
+	if !found {
+		return Check{Name: name, Detail: "not found"}
+	}
+	return Check{Name: name, OK: true, Detail: version}
+}
+
+// Passed reports whether every check passed.
+func Passed(checks []Check) bool {
+	for _, c := range checks {
+		if !c.OK {
+			return false
+		}
+	}
+	return true
+}
+
 // ── Entry point ────────────────────────────────────────────────────
 
 // Gather collects all diagnostic information and returns a populated Report.
@@ -547,6 +596,9 @@ func gatherConfigEntries(cfg *config.Config) []ConfigEntry {
 	if cfg.WorldContractsBranch != "" {
 		entries = append(entries, ConfigEntry{Key: "world-contracts-branch", Value: cfg.WorldContractsBranch})
 	}
+	if cfg.WorldContractsCommit != "" {
+		entries = append(entries, ConfigEntry{Key: "world-contracts-commit", Value: cfg.WorldContractsCommit})
+	}
 	if cfg.BuilderScaffoldURL != "" {
 		entries = append(entries, ConfigEntry{Key: "builder-scaffold-url", Value: cfg.BuilderScaffoldURL})
 	}
@@ -556,12 +608,15 @@ func gatherConfigEntries(cfg *config.Config) []ConfigEntry {
 	if cfg.BuilderScaffoldBranch != "" {
 		entries = append(entries, ConfigEntry{Key: "builder-scaffold-branch", Value: cfg.BuilderScaffoldBranch})
 	}
+	if cfg.BuilderScaffoldCommit != "" {
+		entries = append(entries, ConfigEntry{Key: "builder-scaffold-commit", Value: cfg.BuilderScaffoldCommit})
+	}
 
 	return entries
 }
 func gatherSuiClient() SuiClientInfo {
 	info := SuiClientInfo{}
-	if _, err := exec.LookPath("sui"); err != nil {
+	if _, err := exec.LookPath(sui.Binary()); err != nil {
 		info.Found = false
 		return info
 	}
@@ -573,24 +628,24 @@ func gatherSuiClient() SuiClientInfo {
 	}
 
 	// Gather active environment
-	if out, err := exec.Command("sui", "client", "active-env").Output(); err == nil {
+	if out, err := exec.Command(sui.Binary(), "client", "active-env").Output(); err == nil { // #nosec G204 -- binary is the user-selected sui executable
 		info.ActiveEnv = strings.TrimSpace(string(out))
 	}
 
 	// Gather active address
-	if out, err := exec.Command("sui", "client", "active-address").Output(); err == nil {
+	if out, err := exec.Command(sui.Binary(), "client", "active-address").Output(); err == nil { // #nosec G204 -- binary is the user-selected sui executable
 		info.ActiveAddress = strings.TrimSpace(string(out))
 	}
 
 	// Gather envs to find RPC and Faucet URLs
 	// We use --json for robust parsing if available, but keep a fallback
-	if out, err := exec.Command("sui", "client", "envs", "--json").Output(); err == nil {
+	if out, err := exec.Command(sui.Binary(), "client", "envs", "--json").Output(); err == nil { // #nosec G204 -- binary is the user-selected sui executable
 		info.ActiveEnvRpcUrl, info.ActiveEnvFaucetUrl = parseSuiEnvsJSON(string(out), info.ActiveEnv)
 	}
 
 	// Fallback/Legacy parsing for older Sui versions or if --json failed
 	if info.ActiveEnvRpcUrl == "" {
-		if out, err := exec.Command("sui", "client", "envs").Output(); err == nil {
+		if out, err := exec.Command(sui.Binary(), "client", "envs").Output(); err == nil { // #nosec G204 -- binary is the user-selected sui executable
 			info.ActiveEnvRpcUrl, info.ActiveEnvFaucetUrl = parseSuiEnvsLegacy(string(out), info.ActiveEnv)
 		}
 	}
@@ -339,3 +339,33 @@ func TestGatherRepos_ReturnsBoth(t *testing.T) {
 		}
 	}
 }
+
+func TestReportChecks(t *testing.T) {
+	r := &Report{
+		Container: ContainerRuntimeInfo{Engine: "docker", Version: "24.0.6", Found: true},
+		Node:      NodeInfo{Version: "v24.11.0", Found: true},
+		Env:       EnvironmentInfo{State: "down"},
+		Ports:     []PortInfo{{Port: 9000, Available: true}, {Port: 5432, Available: false}},
+	}
+
+	checks := r.Checks()
+	assert.Equal(t, []Check{
+		{Name: "container-runtime", OK: true, Detail: "docker 24.0.6"},
+		{Name: "node", OK: true, Detail: "v24.11.0"},
+		{Name: "git", OK: false, Detail: "not found"},
+		{Name: "port-9000", OK: true, Detail: "free"},
+		{Name: "port-5432", OK: false, Detail: "in use"},
+	}, checks)
+	assert.False(t, Passed(checks))
+}
+
+func TestReportChecks_PortsInUseWhileEnvUp(t *testing.T) {
+	r := &Report{
+		Container: ContainerRuntimeInfo{Engine: "podman", Version: "4.9.0", Found: true},
+		Node:      NodeInfo{Version: "v24.11.0", Found: true},
+		Git:       GitInfo{Version: "2.43.0", Found: true},
+		Env:       EnvironmentInfo{State: "up"},
+		Ports:     []PortInfo{{Port: 9000, Available: false}},
+	}
+	assert.True(t, Passed(r.Checks()))
+}
@@ -0,0 +1,104 @@
+package doctor
+
+import (
+	"fmt"
+	"strings"
+
+	"efctl/pkg/setup"
+	"efctl/pkg/sui"
+)
+
+// FixResult reports the outcome of one automatic remediation.
+type FixResult struct {
+	Name   string
+	Action string
+	Err    error
+}
+
+// FixOptions configures Fix.
+type FixOptions struct {
+	Workspace string
+	// SwitchSuiEnv makes ef-localhost the active sui environment. Without it
+	// the environment is only created when missing and the user's active
+	// environment is left alone.
+	SwitchSuiEnv bool
+}
+
+// fixers are the remediations run by Fix, in order. Line endings come first
+// so the patch pass sees LF-only scripts.
+var fixers = []struct {
+	name string
+	run  func(opts FixOptions) (string, error)
+}{
+	{"line-endings", fixLineEndings},
+	{"docker-patches", fixDockerPatches},
+	{"sui-env", fixSuiEnv},
+	{"sui-keys", fixSuiKeys},
+}
+
+// Fix applies the safe, idempotent remediations for the issues doctor most
+// often diagnoses: CRLF scripts, reverted Dockerfile/entrypoint.sh patches, a
+// missing ef-localhost sui environment, and missing workspace keys. Every fixer
+// runs even when an earlier one fails.
+func Fix(opts FixOptions) []FixResult {
+	results := make([]FixResult, 0, len(fixers))
+	for _, f := range fixers {
+		action, err := f.run(opts)
+		results = append(results, FixResult{Name: f.name, Action: action, Err: err})
+	}
+	return results
+}
+
+func fixLineEndings(opts FixOptions) (string, error) {
+	fixed, err := setup.NormalizeWorkspaceScripts(opts.Workspace)
+	if err != nil {
+		return "", err
+	}
+	if len(fixed) == 0 {
+		return "no CRLF scripts found", nil
+	}
+	return "converted to LF: " + strings.Join(fixed, ", "), nil
+}
+
+func fixDockerPatches(opts FixOptions) (string, error) {
+	changed, err := setup.ReapplyDockerPatches(opts.Workspace)
+	if err != nil {
+		return "", err
+	}
+	if len(changed) == 0 {
+		return "patches already applied", nil
+	}
+	return "re-applied patches to " + strings.Join(changed, ", "), nil
+}
+
+func fixSuiEnv(opts FixOptions) (string, error) {
+	if !sui.IsSuiInstalled() {
+		return "skipped (sui CLI not found)", nil
+	}
+	created, err := sui.EnsureLocalEnv()
+	if err != nil {
+		return "", err
+	}
+	action := sui.LocalEnvAlias + " already present"
+	if created {
+		action = "created " + sui.LocalEnvAlias
+	}
+	if !opts.SwitchSuiEnv {
+		return action + "; active environment unchanged", nil
+	}
+	if err := sui.UseLocalEnv(); err != nil {
+		return "", err
+	}
+	return action + " and made it active", nil
+}
+
+func fixSuiKeys(opts FixOptions) (string, error) {
+	if !sui.IsSuiInstalled() {
+		return "skipped (sui CLI not found)", nil
+	}
+	summary, err := sui.ImportWorkspaceKeys(opts.Workspace)
+	if err != nil {
+		return "", fmt.Errorf("read workspace keys: %w", err)
+	}
+	return "keys " + summary.String(), nil
+}
@@ -0,0 +1,47 @@
+package doctor
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"efctl/pkg/sui"
+)
+
+func TestFix_ReportsEachFixer(t *testing.T) {
+	sui.SetBinary(filepath.Join(t.TempDir(), "missing-sui"))
+	t.Cleanup(func() { sui.SetBinary("") })
+
+	ws := t.TempDir()
+	scripts := filepath.Join(ws, "builder-scaffold", "docker", "scripts")
+	require.NoError(t, os.MkdirAll(scripts, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(scripts, "entrypoint.sh"), []byte("#!/usr/bin/env bash\r\n"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(ws, "builder-scaffold", "docker", "Dockerfile"), []byte("FROM ubuntu:24.04\n"), 0644))
+
+	results := Fix(FixOptions{Workspace: ws})
+	require.Len(t, results, 4)
+
+	byName := map[string]FixResult{}
+	for _, r := range results {
+		byName[r.Name] = r
+	}
+	assert.NoError(t, byName["line-endings"].Err)
+	assert.Contains(t, byName["line-endings"].Action, "entrypoint.sh")
+	assert.NoError(t, byName["docker-patches"].Err)
+	assert.Contains(t, byName["docker-patches"].Action, "re-applied")
+	assert.Equal(t, "skipped (sui CLI not found)", byName["sui-env"].Action)
+	assert.Equal(t, "skipped (sui CLI not found)", byName["sui-keys"].Action)
+}
+
+func TestFix_MissingWorkspaceContinues(t *testing.T) {
+	sui.SetBinary(filepath.Join(t.TempDir(), "missing-sui"))
+	t.Cleanup(func() { sui.SetBinary("") })
+
+	results := Fix(FixOptions{Workspace: t.TempDir()})
+	require.Len(t, results, 4)
+	assert.Error(t, results[1].Err, "docker patches need builder-scaffold")
+	assert.NoError(t, results[2].Err)
+}
@@ -0,0 +1,89 @@
+package doctor
+
+import (
+	"fmt"
+	"os/exec"
+	"runtime"
+
+	"efctl/pkg/config"
+	"efctl/pkg/env"
+	"efctl/pkg/sui"
+)
+
+// SuiCLIInfo holds the detected host sui binary details.
+type SuiCLIInfo struct {
+	Version string // e.g. "1.66.1-abc123"
+	Path    string // absolute path to the binary
+	Found   bool
+}
+
+// Info is the condensed, static view of an installation rendered by
+// `efctl env info`. Unlike Report it does not probe containers or ports.
+type Info struct {
+	Efctl     EfctlInfo
+	Workspace string
+	Container ContainerRuntimeInfo
+	Node      NodeInfo
+	Git       GitInfo
+	Sui       SuiCLIInfo
+	Repos     []RepoInfo
+	Config    ConfigInfo
+	// Resolved holds the effective configuration values after defaults are applied.
+	Resolved []ConfigEntry
+}
+
+// GatherInfo collects versions, resolved configuration and repository commits
+// for the workspace in opts.
+func GatherInfo(opts Options) *Info {
+	prereqs := opts.Prereqs
+	if prereqs == nil {
+		prereqs = env.CheckPrerequisites()
+	}
+
+	return &Info{
+		Efctl: EfctlInfo{
+			Version:   opts.Version,
+			CommitSHA: opts.CommitSHA,
+			BuildDate: opts.BuildDate,
+			GOOS:      runtime.GOOS,
+			GOARCH:    runtime.GOARCH,
+		},
+		Workspace: opts.Workspace,
+		Container: gatherContainerRuntime(prereqs),
+		Node:      gatherNode(prereqs),
+		Git:       gatherGit(),
+		Sui:       gatherSuiCLI(),
+		Repos:     gatherRepos(opts.Workspace),
+		Config:    gatherConfig(opts.Config, opts.ConfigLoaded, opts.ConfigPath),
+		Resolved:  resolvedConfigEntries(opts.Config),
+	}
+}
+
+func gatherSuiCLI() SuiCLIInfo {
+	path, err := exec.LookPath(sui.Binary())
+	if err != nil {
+		return SuiCLIInfo{Found: false}
+	}
+
+	info := SuiCLIInfo{Path: path, Found: true}
+	if version, err := sui.Version(); err == nil {
+		info.Version = version
+	}
+	return info
+}
+
+// resolvedConfigEntries reports every setting that influences `env up`, using
+// the same getters as the commands so defaults are shown as they apply.
+func resolvedConfigEntries(cfg *config.Config) []ConfigEntry {
+	return []ConfigEntry{
+		{Key: "world-contracts-url", Value: cfg.GetWorldContractsURL()},
+		{Key: "world-contracts-ref", Value: cfg.GetWorldContractsRef()},
+		{Key: "world-contracts-commit", Value: cfg.GetWorldContractsCommit()},
+		{Key: "builder-scaffold-url", Value: cfg.GetBuilderScaffoldURL()},
+		{Key: "builder-scaffold-ref", Value: cfg.GetBuilderScaffoldRef()},
+		{Key: "builder-scaffold-commit", Value: cfg.GetBuilderScaffoldCommit()},
+		{Key: "container-engine", Value: cfg.GetContainerEngine()},
+		{Key: "host", Value: cfg.GetHost()},
+		{Key: "git-autocrlf", Value: fmt.Sprintf("%t", cfg.GetGitAutoCRLF())},
+	}
+}
@@ -0,0 +1,85 @@
+package doctor
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"efctl/pkg/config"
+	"efctl/pkg/env"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolvedConfigEntries_Defaults(t *testing.T) {
+	entries := resolvedConfigEntries(nil)
+
+	values := make(map[string]string, len(entries))
+	for _, e := range entries {
+		values[e.Key] = e.Value
+	}
+
+	assert.Equal(t, config.DefaultWorldContractsURL, values["world-contracts-url"])
+	assert.Equal(t, config.RecommendedWorldContractsRef, values["world-contracts-ref"])
+	assert.Equal(t, config.DefaultBuilderScaffoldURL, values["builder-scaffold-url"])
+	assert.Equal(t, config.RecommendedBuilderScaffoldRef, values["builder-scaffold-ref"])
+	assert.Equal(t, "auto-detect", values["container-engine"])
+	assert.Equal(t, "127.0.0.1", values["host"])
+	assert.Equal(t, "false", values["git-autocrlf"])
+}
+
+func TestResolvedConfigEntries_Overrides(t *testing.T) {
+	cfg := &config.Config{
+		WorldContractsRef: "feature/x",
+		ContainerEngine:   "podman",
+	}
+
+	entries := resolvedConfigEntries(cfg)
+
+	assert.Contains(t, entries, ConfigEntry{Key: "world-contracts-ref", Value: "feature/x"})
+	assert.Contains(t, entries, ConfigEntry{Key: "container-engine", Value: "podman"})
+}
+
+func TestGatherSuiCLI_ParsesVersion(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("shell script stub requires a POSIX shell")
+	}
+
+	binDir := t.TempDir()
+	script := "#!/bin/sh\necho 'sui 1.66.1-abc123'\n"
+	require.NoError(t, os.WriteFile(filepath.Join(binDir, "sui"), []byte(script), 0755))
+	t.Setenv("PATH", binDir)
+
+	info := gatherSuiCLI()
+
+	assert.True(t, info.Found)
+	assert.Equal(t, "1.66.1-abc123", info.Version)
+	assert.Equal(t, filepath.Join(binDir, "sui"), info.Path)
+}
+
+func TestGatherSuiCLI_NotFound(t *testing.T) {
+	t.Setenv("PATH", t.TempDir())
+
+	info := gatherSuiCLI()
+
+	assert.False(t, info.Found)
+	assert.Empty(t, info.Version)
+}
+
+func TestGatherInfo_NonNil(t *testing.T) {
+	tmp := t.TempDir()
+
+	info := GatherInfo(Options{
+		Workspace: tmp,
+		Version:   "v1.0.0",
+		Prereqs:   &env.CheckResult{},
+	})
+
+	require.NotNil(t, info)
+	assert.Equal(t, "v1.0.0", info.Efctl.Version)
+	assert.Equal(t, tmp, info.Workspace)
+	assert.Len(t, info.Repos, 2)
+	assert.NotEmpty(t, info.Resolved)
+	assert.False(t, info.Config.Loaded)
+}
@@ -8,6 +8,8 @@ import (
 	"io"
 	"net/http"
 	"os"
+
+	"efctl/pkg/httpclient"
 )
 
 // GenerateRandomPassword generates a cryptographically secure random password
@@ -43,7 +45,7 @@ func VerifySHA256(filePath, expectedHash string) (bool, error) {
 
 // DownloadFile downloads a file from the specified URL to the local filePath.
 func DownloadFile(url, filePath string) error {
-	resp, err := http.Get(url) // #nosec G107
+	resp, err := httpclient.New(0).Get(url) // #nosec G107
 	if err != nil {
 		return fmt.Errorf("failed to download file: %w", err)
 	}
@@ -1,6 +1,8 @@
 package git
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
@@ -14,6 +16,7 @@ import (
 
 func ensureGitRepository(path string) error {
 	cmd := exec.Command("git", "-C", path, "rev-parse", "--is-inside-work-tree") // #nosec G204 -- "git" is a hardcoded binary; path is a -C directory argument, not a shell command
+	ui.Command(cmd.Args[0], cmd.Args[1:]...)
 	output, err := cmd.CombinedOutput()
 	if err != nil {
 		return fmt.Errorf("path %s is not a git repository: %v\n%s", path, err, string(output))
@@ -29,8 +32,9 @@ func ensureGitRepository(path string) error {
 // GitClient defines the interface for git operations.
 // Consumers should accept this interface to enable testing with mocks.
 type GitClient interface {
-	CloneRepository(url string, dest string) error
-	CheckoutRef(repoPath string, ref string) error
+	CloneRepository(ctx context.Context, url string, dest string) error
+	CheckoutRef(ctx context.Context, repoPath string, ref string) error
+	HeadCommit(repoPath string) (string, error)
 	SetupWorkDir(path string) error
 }
 
@@ -46,13 +50,18 @@ func NewClient() *DefaultClient {
 }
 
 // CloneRepository clones a git repository to a specific path
-func (g *DefaultClient) CloneRepository(url string, dest string) error {
-	return CloneRepository(url, dest)
+func (g *DefaultClient) CloneRepository(ctx context.Context, url string, dest string) error {
+	return CloneRepository(ctx, url, dest)
 }
 
 // CheckoutRef checks out the specified ref (branch, tag, or commit) in the given repository path.
-func (g *DefaultClient) CheckoutRef(repoPath string, ref string) error {
-	return CheckoutRef(repoPath, ref)
+func (g *DefaultClient) CheckoutRef(ctx context.Context, repoPath string, ref string) error {
+	return CheckoutRef(ctx, repoPath, ref)
+}
+
+// HeadCommit returns the full SHA of HEAD in the given repository path.
+func (g *DefaultClient) HeadCommit(repoPath string) (string, error) {
+	return HeadCommit(repoPath)
 }
 
 // SetupWorkDir creates the workspace directory if it doesn't exist
@@ -60,17 +69,18 @@ func (g *DefaultClient) SetupWorkDir(path string) error {
 	return SetupWorkDir(path)
 }
 
-// CloneRepository clones a git repository to a specific path
-func CloneRepository(url string, dest string) error {
+// CloneRepository clones a git repository to a specific path. Cancelling ctx
+// kills the running git command and stops any retries.
+func CloneRepository(ctx context.Context, url string, dest string) error {
 	// Check if directory already exists
 	if _, err := os.Stat(dest); !os.IsNotExist(err) {
-		return updateExistingRepository(url, dest)
+		return updateExistingRepository(ctx, url, dest)
 	}
 
-	return cloneNewRepository(url, dest)
+	return cloneNewRepository(ctx, url, dest)
 }
 
-func updateExistingRepository(url string, dest string) error {
+func updateExistingRepository(ctx context.Context, url string, dest string) error {
 	if err := ensureGitRepository(dest); err != nil {
 		return err
 	}
@@ -84,7 +94,7 @@ func updateExistingRepository(url string, dest string) error {
 	}
 
 	// Fetch from the updated remote with retry logic
-	if err := fetchWithRetry(dest, url); err != nil {
+	if err := fetchWithRetry(ctx, dest, url); err != nil {
 		spinner.Fail(fmt.Sprintf("Failed to fetch from %s", url))
 		return err
 	}
@@ -94,7 +104,7 @@ func updateExistingRepository(url string, dest string) error {
 	return nil
 }
 
-func cloneNewRepository(url string, dest string) error {
+func cloneNewRepository(ctx context.Context, url string, dest string) error {
 	spinner, _ := ui.Spin(fmt.Sprintf("%s Cloning %s...", ui.GitEmoji, url))
 
 	autocrlf := "false"
@@ -105,20 +115,28 @@ func cloneNewRepository(url string, dest string) error {
 	var lastErr error
 	var output []byte
 	for attempt := 1; attempt <= 3; attempt++ {
-		cmd := exec.Command("git", "clone", "-c", "core.autocrlf="+autocrlf, url, dest) // #nosec G204 -- "git" is a hardcoded binary; url/dest come from validated config, autocrlf is "true" or "false"
+		cmd := exec.CommandContext(ctx, "git", "clone", "-c", "core.autocrlf="+autocrlf, url, dest) // #nosec G204 -- "git" is a hardcoded binary; url/dest come from validated config, autocrlf is "true" or "false"
+		ui.Command(cmd.Args[0], cmd.Args[1:]...)
 		output, lastErr = cmd.CombinedOutput()
 		if lastErr == nil {
 			spinner.Success(fmt.Sprintf("Cloned %s", dest))
 			return nil
 		}
 
+		if ctx.Err() != nil {
+			spinner.Fail(fmt.Sprintf("Cancelled cloning %s", url))
+			return fmt.Errorf("git clone %s: %w", url, ctx.Err())
+		}
 		if !isRetriableGitError(string(output), lastErr) || attempt == 3 {
 			break
 		}
 
 		delay := time.Duration(1<<uint(attempt)) * time.Second
 		spinner.UpdateText(fmt.Sprintf("Clone attempt %d failed, retrying in %v...", attempt, delay))
-		time.Sleep(delay)
+		if err := sleepContext(ctx, delay); err != nil {
+			spinner.Fail(fmt.Sprintf("Cancelled cloning %s", url))
+			return fmt.Errorf("git clone %s: %w", url, err)
+		}
 		spinner.UpdateText(fmt.Sprintf("%s Cloning %s (attempt %d/3)...", ui.GitEmoji, url, attempt+1))
 	}
 
@@ -128,8 +146,10 @@ func cloneNewRepository(url string, dest string) error {
 
 func setOrAddRemote(dest, url string) error {
 	cmd := exec.Command("git", "-C", dest, "remote", "set-url", "origin", url) // #nosec G204 -- "git" is a hardcoded binary; dest/url come from validated config
+	ui.Command(cmd.Args[0], cmd.Args[1:]...)
 	if err := cmd.Run(); err != nil {
 		cmd = exec.Command("git", "-C", dest, "remote", "add", "origin", url) // #nosec G204 -- "git" is a hardcoded binary; dest/url come from validated config
+		ui.Command(cmd.Args[0], cmd.Args[1:]...)
 		if err := cmd.Run(); err != nil {
 			ui.Debug.Printf("failed to set or add remote origin %s: %v", url, err)
 			return fmt.Errorf("failed to configure remote origin for %s: %w", dest, err)
@@ -138,28 +158,47 @@ func setOrAddRemote(dest, url string) error {
 	return nil
 }
 
-func fetchWithRetry(dest, url string) error {
+func fetchWithRetry(ctx context.Context, dest, url string) error {
 	var fetchErr error
 	var fetchOutput []byte
 	for attempt := 1; attempt <= 3; attempt++ {
-		cmd := exec.Command("git", "-C", dest, "fetch", "origin") // #nosec G204 -- "git" is a hardcoded binary; dest comes from validated config
+		cmd := exec.CommandContext(ctx, "git", "-C", dest, "fetch", "origin") // #nosec G204 -- "git" is a hardcoded binary; dest comes from validated config
+		ui.Command(cmd.Args[0], cmd.Args[1:]...)
 		fetchOutput, fetchErr = cmd.CombinedOutput()
 		if fetchErr == nil {
 			return nil
 		}
 
+		if ctx.Err() != nil {
+			return fmt.Errorf("git fetch in %s: %w", dest, ctx.Err())
+		}
 		if !isRetriableGitError(string(fetchOutput), fetchErr) || attempt == 3 {
 			break
 		}
 
 		delay := time.Duration(1<<uint(attempt)) * time.Second
 		ui.Debug.Println(fmt.Sprintf("Git fetch attempt %d failed, retrying in %v...", attempt, delay))
-		time.Sleep(delay)
+		if err := sleepContext(ctx, delay); err != nil {
+			return fmt.Errorf("git fetch in %s: %w", dest, err)
+		}
 	}
 	ui.Debug.Printf("git fetch error: %v\n%s", fetchErr, string(fetchOutput))
 	return fmt.Errorf("failed to fetch remote for %s: %v\n%s", dest, fetchErr, string(fetchOutput))
 }
 
+// sleepContext waits for d, returning early with ctx's error if it is
+// cancelled first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
 func ensureAutocrlf(dest string) {
 	autocrlf := "false"
 	if config.Loaded.GetGitAutoCRLF() {
@@ -198,7 +237,7 @@ func isRetriableGitError(output string, err error) bool {
 }
 
 // CheckoutRef checks out the specified ref (branch, tag, or commit) in the given repository path.
-func CheckoutRef(repoPath string, ref string) error {
+func CheckoutRef(ctx context.Context, repoPath string, ref string) error {
 	if err := ensureGitRepository(repoPath); err != nil {
 		return err
 	}
@@ -211,21 +250,26 @@ func CheckoutRef(repoPath string, ref string) error {
 		autocrlf = "true"
 	}
 	cmdConfig := exec.Command("git", "-C", repoPath, "config", "core.autocrlf", autocrlf) // #nosec G204 -- "git" is a hardcoded binary; autocrlf is "true" or "false" only
-	cmdConfig.Run()                                                                       // #nosec G104 -- config errors are non-fatal
+	ui.Command(cmdConfig.Args[0], cmdConfig.Args[1:]...)
+	cmdConfig.Run() // #nosec G104 -- config errors are non-fatal
 
-	cmd := exec.Command("git", "-C", repoPath, "checkout", ref) // #nosec G204 -- "git" is a hardcoded binary; ref comes from validated config
+	cmd := exec.CommandContext(ctx, "git", "-C", repoPath, "checkout", ref) // #nosec G204 -- "git" is a hardcoded binary; ref comes from validated config
+	ui.Command(cmd.Args[0], cmd.Args[1:]...)
 	output, err := cmd.CombinedOutput()
 	if err != nil {
 		spinner.Fail(fmt.Sprintf("Failed to checkout ref '%s'", ref))
+		if isMissingRefOutput(string(output)) {
+			return refNotFoundError(ctx, repoPath, ref)
+		}
 		return fmt.Errorf("git checkout error: %v\n%s", err, string(output))
 	}
 
-	// Try to pull latest changes only if it looks like a branch (not a full 40-char commit hash and not a tag-like ref)
-	// This is a heuristic: if it's not a 40-char hex string, we'll try to pull.
+	// Try to pull latest changes only if it looks like a branch (not a commit hash and not a tag-like ref)
+	// This is a heuristic: if it's not a 7-40 char hex string, we'll try to pull.
 	// Tags will fail the pull but we ignore errors anyway.
-	isCommit, _ := regexp.MatchString(`^[0-9a-fA-F]{40}$`, ref)
-	if !isCommit {
-		cmd = exec.Command("git", "-C", repoPath, "pull", "origin", ref) // #nosec G204 -- "git" is a hardcoded binary; ref comes from validated config
+	if !isCommitSHA(ref) {
+		cmd = exec.CommandContext(ctx, "git", "-C", repoPath, "pull", "origin", ref) // #nosec G204 -- "git" is a hardcoded binary; ref comes from validated config
+		ui.Command(cmd.Args[0], cmd.Args[1:]...)
 		// We ignore pull errors since the ref might be local-only or already up-to-date
 		cmd.Run() // #nosec G104 -- pull errors intentionally ignored
 	}
@@ -234,6 +278,72 @@ func CheckoutRef(repoPath string, ref string) error {
 	return nil
 }
 
+// commitSHARe matches full and abbreviated commit hashes, the same 7-40 hex
+// characters the config accepts for pinned commits.
+var commitSHARe = regexp.MustCompile(`^[0-9a-fA-F]{7,40}$`)
+
+// isCommitSHA reports whether ref looks like a commit hash. Checking one out
+// leaves a detached HEAD, which cannot be pulled.
+func isCommitSHA(ref string) bool {
+	return commitSHARe.MatchString(ref)
+}
+
+// ErrRefNotFound is returned by CheckoutRef when the ref does not exist in
+// the repository or its remote.
+var ErrRefNotFound = errors.New("ref not found")
+
+// maxListedBranches caps how many remote branches a not-found error lists.
+const maxListedBranches = 10
+
+func isMissingRefOutput(output string) bool {
+	lower := strings.ToLower(output)
+	return strings.Contains(lower, "did not match any file(s) known to git") ||
+		strings.Contains(lower, "pathspec") ||
+		strings.Contains(lower, "invalid reference")
+}
+
+// refNotFoundError builds an ErrRefNotFound error listing the branches the
+// remote does have, so a typo in the configured ref is easy to spot.
+func refNotFoundError(ctx context.Context, repoPath, ref string) error {
+	cmd := exec.CommandContext(ctx, "git", "-C", repoPath, "ls-remote", "--heads", "origin") // #nosec G204 -- "git" is a hardcoded binary; repoPath is a -C directory argument
+	ui.Command(cmd.Args[0], cmd.Args[1:]...)
+	output, err := cmd.Output()
+	if err != nil {
+		return fmt.Errorf("%w: %q in %s", ErrRefNotFound, ref, repoPath)
+	}
+	branches := parseRemoteHeads(string(output))
+	if len(branches) > maxListedBranches {
+		branches = append(branches[:maxListedBranches], "...")
+	}
+	return fmt.Errorf("%w: %q in %s; available branches: %s", ErrRefNotFound, ref, repoPath, strings.Join(branches, ", "))
+}
+
+// parseRemoteHeads extracts branch names from `git ls-remote --heads` output.
+func parseRemoteHeads(output string) []string {
+	var branches []string
+	for _, line := range strings.Split(output, "\n") {
+		_, ref, ok := strings.Cut(strings.TrimSpace(line), "\t")
+		if !ok {
+			continue
+		}
+		if name, found := strings.CutPrefix(ref, "refs/heads/"); found {
+			branches = append(branches, name)
+		}
+	}
+	return branches
+}
+
+// HeadCommit returns the full SHA of HEAD in the given repository path.
+func HeadCommit(repoPath string) (string, error) {
+	cmd := exec.Command("git", "-C", repoPath, "rev-parse", "HEAD") // #nosec G204 -- "git" is a hardcoded binary; repoPath is a -C directory argument
+	ui.Command(cmd.Args[0], cmd.Args[1:]...)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("git rev-parse HEAD failed in %s: %v\n%s", repoPath, err, string(output))
+	}
+	return strings.TrimSpace(string(output)), nil
+}
+
 // NormalizeLineEndings ensures a file has LF line endings (mimicks dos2unix).
 func NormalizeLineEndings(path string) error {
 	info, err := os.Stat(path)
@@ -259,11 +369,19 @@ func NormalizeLineEndings(path string) error {
 
 // SetupWorkDir creates the workspace directory if it doesn't exist
 func SetupWorkDir(path string) error {
-	if _, err := os.Stat(path); os.IsNotExist(err) {
+	info, err := os.Stat(path)
+	if os.IsNotExist(err) {
 		err := os.MkdirAll(path, 0750)
 		if err != nil {
 			return fmt.Errorf("failed to create directory %s: %w", path, err)
 		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to stat workspace path %s: %w", path, err)
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("workspace path %s exists and is not a directory", path)
 	}
 	return nil
 }
@@ -1,6 +1,8 @@
 package git
 
 import (
+	"context"
+	"errors"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -39,6 +41,21 @@ func TestSetupWorkDir(t *testing.T) {
 	}
 }
 
+func TestSetupWorkDir_PathIsFile(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "workspace")
+	if err := os.WriteFile(filePath, []byte("not a directory"), 0600); err != nil {
+		t.Fatalf("Failed to create file: %v", err)
+	}
+
+	err := SetupWorkDir(filePath)
+	if err == nil {
+		t.Fatal("Expected error when workspace path is a file")
+	}
+	if !strings.Contains(err.Error(), "exists and is not a directory") {
+		t.Fatalf("Expected not-a-directory error, got: %v", err)
+	}
+}
+
 func TestCloneRepository_InvalidURL(t *testing.T) {
 	// We pass an invalid URL, we expect an error from git clone
 	tempDir, err := os.MkdirTemp("", "efctl-git-test-*")
@@ -49,12 +66,23 @@ func TestCloneRepository_InvalidURL(t *testing.T) {
 
 	dest := filepath.Join(tempDir, "invalid-repo")
 
-	err = CloneRepository("https://invalid.url.that.does.not.exist/repo.git", dest)
+	err = CloneRepository(context.Background(), "https://invalid.url.that.does.not.exist/repo.git", dest)
 	if err == nil {
 		t.Errorf("Expected an error when cloning an invalid URL, got nil")
 	}
 }
 
+func TestCloneRepository_CancelledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	dest := filepath.Join(t.TempDir(), "cancelled-repo")
+	err := CloneRepository(ctx, "https://invalid.url.that.does.not.exist/repo.git", dest)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected context.Canceled, got: %v", err)
+	}
+}
+
 func TestCloneRepository_DirectoryExists(t *testing.T) {
 	tempDir, err := os.MkdirTemp("", "efctl-git-test-*")
 	if err != nil {
@@ -72,7 +100,7 @@ func TestCloneRepository_DirectoryExists(t *testing.T) {
 	}
 
 	// Should return nil because directory already exists and remote was added/fetched successfully
-	err = CloneRepository("https://github.com/evefrontier/world-contracts.git", dest)
+	err = CloneRepository(context.Background(), "https://github.com/evefrontier/world-contracts.git", dest)
 	if err != nil {
 		t.Errorf("Expected nil error when directory already exists and remote updated, got: %v", err)
 	}
@@ -90,7 +118,7 @@ func TestCloneRepository_DirectoryExistsNotGitRepoFails(t *testing.T) {
 		t.Fatalf("Failed to create directory: %v", err)
 	}
 
-	err = CloneRepository("https://github.com/evefrontier/world-contracts.git", dest)
+	err = CloneRepository(context.Background(), "https://github.com/evefrontier/world-contracts.git", dest)
 	if err == nil {
 		t.Fatal("Expected an error when destination exists but is not a git repository")
 	}
@@ -107,7 +135,7 @@ func TestCheckoutBranch_NonGitRepoFails(t *testing.T) {
 	}
 	defer os.RemoveAll(tempDir)
 
-	err = CheckoutRef(tempDir, "main")
+	err = CheckoutRef(context.Background(), tempDir, "main")
 	if err == nil {
 		t.Fatal("Expected checkout to fail for non-git directory")
 	}
@@ -116,3 +144,78 @@ func TestCheckoutBranch_NonGitRepoFails(t *testing.T) {
 		t.Fatalf("Expected not-a-git-repository error, got: %v", err)
 	}
 }
+
+func TestHeadCommit(t *testing.T) {
+	dir := t.TempDir()
+	for _, args := range [][]string{
+		{"init"},
+		{"-c", "user.name=efctl", "-c", "user.email=efctl@example.com", "commit", "--allow-empty", "-m", "init"},
+	} {
+		cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Skipf("git unavailable: %v\n%s", err, out)
+		}
+	}
+
+	sha, err := HeadCommit(dir)
+	if err != nil {
+		t.Fatalf("HeadCommit failed: %v", err)
+	}
+	if len(sha) != 40 {
+		t.Fatalf("expected 40-char SHA, got %q", sha)
+	}
+}
+
+func TestHeadCommit_NonGitRepoFails(t *testing.T) {
+	if _, err := HeadCommit(t.TempDir()); err == nil {
+		t.Fatal("Expected HeadCommit to fail for non-git directory")
+	}
+}
+
+func TestCheckoutRef_MissingRefListsRemoteBranches(t *testing.T) {
+	origin := t.TempDir()
+	clone := filepath.Join(t.TempDir(), "clone")
+	for _, args := range [][]string{
+		{"-C", origin, "init", "-b", "main"},
+		{"-C", origin, "-c", "user.name=efctl", "-c", "user.email=efctl@example.com", "commit", "--allow-empty", "-m", "init"},
+		{"-C", origin, "branch", "develop"},
+		{"clone", origin, clone},
+	} {
+		if out, err := exec.Command("git", args...).CombinedOutput(); err != nil {
+			t.Skipf("git unavailable: %v\n%s", err, out)
+		}
+	}
+
+	err := CheckoutRef(context.Background(), clone, "no-such-branch")
+	if !errors.Is(err, ErrRefNotFound) {
+		t.Fatalf("expected ErrRefNotFound, got: %v", err)
+	}
+	if !strings.Contains(err.Error(), "available branches: develop, main") {
+		t.Fatalf("expected remote branches in error, got: %v", err)
+	}
+}
+
+func TestParseRemoteHeads(t *testing.T) {
+	out := "abc123\trefs/heads/main\ndef456\trefs/heads/feature/x\nbad line\n"
+	got := strings.Join(parseRemoteHeads(out), ",")
+	if got != "main,feature/x" {
+		t.Fatalf("unexpected branches: %q", got)
+	}
+}
+
+func TestIsCommitSHA(t *testing.T) {
+	cases := map[string]bool{
+		"0123456789abcdef0123456789abcdef01234567": true,
+		"abc1234":    true,
+		"ABCDEF0123": true,
+		"abc123":     false,
+		"main":       false,
+		"v1.2.3":     false,
+		"0123456789abcdef0123456789abcdef012345678": false,
+	}
+	for ref, want := range cases {
+		if got := isCommitSHA(ref); got != want {
+			t.Errorf("isCommitSHA(%q) = %v, want %v", ref, got, want)
+		}
+	}
+}
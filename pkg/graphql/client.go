@@ -2,6 +2,7 @@ package graphql
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -10,6 +11,7 @@ import (
 	"os"
 	"time"
 
+	"efctl/pkg/httpclient"
 	"efctl/pkg/ui"
 	"github.com/jedib0t/go-pretty/v6/table"
 )
@@ -33,6 +35,11 @@ type GraphQLResponse struct {
 // The endpoint must use http:// or https:// scheme. Non-loopback endpoints
 // trigger a security warning (SSRF defense-in-depth).
 func RunQuery(endpoint, query string, variables map[string]interface{}) (*GraphQLResponse, error) {
+	return RunQueryContext(context.Background(), endpoint, query, variables)
+}
+
+// RunQueryContext is RunQuery with a context; cancelling ctx aborts the request.
+func RunQueryContext(ctx context.Context, endpoint, query string, variables map[string]interface{}) (*GraphQLResponse, error) {
 	reqBody := GraphQLRequest{
 		Query:     query,
 		Variables: variables,
@@ -58,16 +65,14 @@ func RunQuery(endpoint, query string, variables map[string]interface{}) (*GraphQ
 		fmt.Fprintf(os.Stderr, "Warning: connecting to remote GraphQL endpoint %s\n", endpoint)
 	}
 
-	req, err := http.NewRequest("POST", endpoint, bytes.NewBuffer(jsonData))
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewBuffer(jsonData))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	req.Header.Set("Content-Type", "application/json")
 
-	client := &http.Client{
-		Timeout: 15 * time.Second,
-	}
+	client := httpclient.NewEndpoint(15 * time.Second)
 	resp, err := client.Do(req) // #nosec G107 -- endpoint validated above; user-supplied by design for dev tool
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute request: %w", err)
@@ -0,0 +1,68 @@
+// Package httpclient builds the http.Client values efctl uses for RPC,
+// GraphQL, faucet, and download requests, so proxy and TLS handling is
+// consistent.
+package httpclient
+
+import (
+	"crypto/tls"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// NoProxy makes clients connect directly, ignoring HTTP_PROXY, HTTPS_PROXY,
+// and NO_PROXY. cmd sets it from --no-proxy.
+var NoProxy bool
+
+// InsecureSkipTLSVerify disables certificate verification for clients from
+// NewEndpoint, for internal RPC and GraphQL endpoints with self-signed
+// certificates. cmd sets it from --insecure-skip-tls-verify. It never
+// applies to New, which is used for release downloads.
+var InsecureSkipTLSVerify bool
+
+type transportKey struct {
+	noProxy  bool
+	insecure bool
+}
+
+var (
+	mu         sync.Mutex
+	transports = map[transportKey]*http.Transport{}
+)
+
+// New returns a client with the given timeout (0 means none) that uses the
+// shared transport.
+func New(timeout time.Duration) *http.Client {
+	return &http.Client{Timeout: timeout, Transport: Transport()}
+}
+
+// NewEndpoint is like New but honours InsecureSkipTLSVerify. Use it only for
+// user-configurable RPC and GraphQL endpoints.
+func NewEndpoint(timeout time.Duration) *http.Client {
+	return &http.Client{Timeout: timeout, Transport: transportFor(transportKey{NoProxy, InsecureSkipTLSVerify})}
+}
+
+// Transport returns the shared transport. It honours the proxy environment
+// variables unless NoProxy is set, and is cached per setting so connections
+// are pooled across clients.
+func Transport() *http.Transport {
+	return transportFor(transportKey{noProxy: NoProxy})
+}
+
+func transportFor(key transportKey) *http.Transport {
+	mu.Lock()
+	defer mu.Unlock()
+	if t, ok := transports[key]; ok {
+		return t
+	}
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	t.Proxy = http.ProxyFromEnvironment
+	if key.noProxy {
+		t.Proxy = nil
+	}
+	if key.insecure {
+		t.TLSClientConfig = &tls.Config{InsecureSkipVerify: true} // #nosec G402 -- opt-in via --insecure-skip-tls-verify for self-signed internal endpoints
+	}
+	transports[key] = t
+	return t
+}
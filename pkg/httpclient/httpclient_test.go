@@ -0,0 +1,53 @@
+package httpclient
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransport_HonoursProxyEnvironment(t *testing.T) {
+	t.Setenv("HTTP_PROXY", "http://proxy.example:3128")
+	old := NoProxy
+	defer func() { NoProxy = old }()
+
+	NoProxy = false
+	req, err := http.NewRequest("GET", "http://rpc.example:9000", nil)
+	require.NoError(t, err)
+	tr := Transport()
+	require.NotNil(t, tr.Proxy)
+	proxyURL, err := tr.Proxy(req)
+	require.NoError(t, err)
+	require.NotNil(t, proxyURL)
+	assert.Equal(t, "proxy.example:3128", proxyURL.Host)
+
+	NoProxy = true
+	assert.Nil(t, Transport().Proxy)
+}
+
+func TestNew_SharesTransport(t *testing.T) {
+	a := New(time.Second)
+	b := New(0)
+	assert.Equal(t, time.Second, a.Timeout)
+	assert.Same(t, a.Transport, b.Transport)
+}
+
+func TestNewEndpoint_InsecureSkipTLSVerify(t *testing.T) {
+	old := InsecureSkipTLSVerify
+	defer func() { InsecureSkipTLSVerify = old }()
+
+	InsecureSkipTLSVerify = true
+	tr := NewEndpoint(time.Second).Transport.(*http.Transport)
+	require.NotNil(t, tr.TLSClientConfig)
+	assert.True(t, tr.TLSClientConfig.InsecureSkipVerify)
+
+	secure := New(time.Second).Transport.(*http.Transport)
+	assert.True(t, secure.TLSClientConfig == nil || !secure.TLSClientConfig.InsecureSkipVerify, "New must always verify certificates")
+
+	InsecureSkipTLSVerify = false
+	tr = NewEndpoint(time.Second).Transport.(*http.Transport)
+	assert.True(t, tr.TLSClientConfig == nil || !tr.TLSClientConfig.InsecureSkipVerify)
+}
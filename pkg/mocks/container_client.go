@@ -2,6 +2,7 @@ package mocks
 
 import (
 	"context"
+	"io"
 	"time"
 
 	"efctl/pkg/container"
@@ -49,6 +50,16 @@ func (m *MockContainerClient) StopContainer(ctx context.Context, name string) er
 	return args.Error(0)
 }
 
+func (m *MockContainerClient) ContainerHealth(name string) (string, error) {
+	args := m.Called(name)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockContainerClient) RestartContainer(ctx context.Context, name string) error {
+	args := m.Called(ctx, name)
+	return args.Error(0)
+}
+
 func (m *MockContainerClient) RemoveContainer(ctx context.Context, name string) error {
 	args := m.Called(ctx, name)
 	return args.Error(0)
@@ -94,11 +105,21 @@ func (m *MockContainerClient) InteractiveShell(containerName string) error {
 	return args.Error(0)
 }
 
+func (m *MockContainerClient) ExecInteractive(containerName string, command []string) error {
+	args := m.Called(containerName, command)
+	return args.Error(0)
+}
+
 func (m *MockContainerClient) Exec(containerName string, command []string) error {
 	args := m.Called(containerName, command)
 	return args.Error(0)
 }
 
+func (m *MockContainerClient) ExecTee(ctx context.Context, containerName string, command []string, w io.Writer) error {
+	args := m.Called(ctx, containerName, command, w)
+	return args.Error(0)
+}
+
 func (m *MockContainerClient) ExecCapture(containerName string, command []string) (string, error) {
 	args := m.Called(containerName, command)
 	return args.String(0), args.Error(1)
@@ -108,7 +129,7 @@ func (m *MockContainerClient) RemoveImages(names []string) {
 	m.Called(names)
 }
 
-func (m *MockContainerClient) Cleanup() error {
-	args := m.Called()
+func (m *MockContainerClient) Cleanup(opts container.CleanupOptions) error {
+	args := m.Called(opts)
 	return args.Error(0)
 }
@@ -1,6 +1,8 @@
 package mocks
 
 import (
+	"context"
+
 	"github.com/stretchr/testify/mock"
 )
 
@@ -9,16 +11,21 @@ type MockGitClient struct {
 	mock.Mock
 }
 
-func (m *MockGitClient) CloneRepository(url string, dest string) error {
-	args := m.Called(url, dest)
+func (m *MockGitClient) CloneRepository(ctx context.Context, url string, dest string) error {
+	args := m.Called(ctx, url, dest)
 	return args.Error(0)
 }
 
-func (m *MockGitClient) CheckoutBranch(repoPath string, branch string) error {
-	args := m.Called(repoPath, branch)
+func (m *MockGitClient) CheckoutRef(ctx context.Context, repoPath string, ref string) error {
+	args := m.Called(ctx, repoPath, ref)
 	return args.Error(0)
 }
 
+func (m *MockGitClient) HeadCommit(repoPath string) (string, error) {
+	args := m.Called(repoPath)
+	return args.String(0), args.Error(1)
+}
+
 func (m *MockGitClient) SetupWorkDir(path string) error {
 	args := m.Called(path)
 	return args.Error(0)
@@ -0,0 +1,95 @@
+// Package secrets keeps workspace private keys in the OS keyring so they do not
+// have to sit in plaintext in world-contracts/.env.
+package secrets
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/zalando/go-keyring"
+)
+
+// Service is the keyring service name efctl stores keys under.
+const Service = "efctl"
+
+// Store is the subset of keyring operations efctl needs.
+type Store interface {
+	Get(service, user string) (string, error)
+	Set(service, user, secret string) error
+}
+
+type osKeyring struct{}
+
+func (osKeyring) Get(service, user string) (string, error) { return keyring.Get(service, user) }
+
+func (osKeyring) Set(service, user, secret string) error { return keyring.Set(service, user, secret) }
+
+// Default is the OS keyring (Keychain, Secret Service, or Windows Credential Manager).
+var Default Store = osKeyring{}
+
+var privateKeyLineRegex = regexp.MustCompile(`^([A-Z][A-Z0-9_]*_PRIVATE_KEY)=(suiprivkey[a-z0-9]+)\s*$`)
+
+// account scopes a variable to the .env file it came from so several
+// workspaces can keep different keys in the same keyring.
+func account(envPath, name string) string {
+	if abs, err := filepath.Abs(envPath); err == nil {
+		envPath = abs
+	}
+	return envPath + ":" + name
+}
+
+// Lookup returns the keyring value of name for envPath, or "" when the key is
+// not stored or no keyring is available.
+func Lookup(store Store, envPath, name string) string {
+	value, err := store.Get(Service, account(envPath, name))
+	if err != nil {
+		return ""
+	}
+	return value
+}
+
+// Overlay fills blank <ROLE>_PRIVATE_KEY entries in env with keyring values,
+// leaving keys that are still present in the .env file untouched.
+func Overlay(store Store, envPath string, env map[string]string) {
+	for name, value := range env {
+		if strings.HasSuffix(name, "_PRIVATE_KEY") && strings.TrimSpace(value) == "" {
+			if key := Lookup(store, envPath, name); key != "" {
+				env[name] = key
+			}
+		}
+	}
+}
+
+// Import moves every <ROLE>_PRIVATE_KEY value in envPath into the keyring and
+// blanks it in the file. It returns the names of the imported variables.
+func Import(store Store, envPath string) ([]string, error) {
+	data, err := os.ReadFile(envPath) // #nosec G304 -- envPath is the workspace .env chosen by the caller
+	if err != nil {
+		return nil, err
+	}
+
+	lines := strings.Split(string(data), "\n")
+	var imported []string
+	for i, line := range lines {
+		match := privateKeyLineRegex.FindStringSubmatch(strings.TrimSpace(line))
+		if match == nil {
+			continue
+		}
+		if err := store.Set(Service, account(envPath, match[1]), match[2]); err != nil {
+			return imported, fmt.Errorf("failed to store %s in the keyring: %w", match[1], err)
+		}
+		lines[i] = match[1] + "="
+		imported = append(imported, match[1])
+	}
+
+	if len(imported) == 0 {
+		return nil, nil
+	}
+	if err := os.WriteFile(envPath, []byte(strings.Join(lines, "\n")), 0600); err != nil {
+		return imported, fmt.Errorf("keys were stored in the keyring but %s could not be rewritten: %w", envPath, err)
+	}
+	return imported, nil
+}
@@ -0,0 +1,83 @@
+package secrets
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeStore map[string]string
+
+func (f fakeStore) Get(service, user string) (string, error) {
+	if v, ok := f[service+"/"+user]; ok {
+		return v, nil
+	}
+	return "", errors.New("not found")
+}
+
+func (f fakeStore) Set(service, user, secret string) error {
+	f[service+"/"+user] = secret
+	return nil
+}
+
+func TestImport_MovesKeysToStore(t *testing.T) {
+	envPath := filepath.Join(t.TempDir(), ".env")
+	content := "ADMIN_ADDRESS=0xabc\nADMIN_PRIVATE_KEY=suiprivkey1aaa\nPLAYER_A_PRIVATE_KEY=suiprivkey1bbb\n"
+	require.NoError(t, os.WriteFile(envPath, []byte(content), 0600))
+
+	store := fakeStore{}
+	imported, err := Import(store, envPath)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ADMIN_PRIVATE_KEY", "PLAYER_A_PRIVATE_KEY"}, imported)
+
+	data, err := os.ReadFile(envPath)
+	require.NoError(t, err)
+	assert.Equal(t, "ADMIN_ADDRESS=0xabc\nADMIN_PRIVATE_KEY=\nPLAYER_A_PRIVATE_KEY=\n", string(data))
+	assert.NotContains(t, string(data), "suiprivkey")
+
+	assert.Equal(t, "suiprivkey1aaa", Lookup(store, envPath, "ADMIN_PRIVATE_KEY"))
+	assert.Equal(t, "suiprivkey1bbb", Lookup(store, envPath, "PLAYER_A_PRIVATE_KEY"))
+}
+
+func TestImport_NoKeysLeavesFileUntouched(t *testing.T) {
+	envPath := filepath.Join(t.TempDir(), ".env")
+	require.NoError(t, os.WriteFile(envPath, []byte("ADMIN_PRIVATE_KEY=\n"), 0600))
+
+	imported, err := Import(fakeStore{}, envPath)
+	require.NoError(t, err)
+	assert.Empty(t, imported)
+}
+
+func TestLookup_ScopedToEnvFile(t *testing.T) {
+	dir := t.TempDir()
+	first := filepath.Join(dir, "a", ".env")
+	second := filepath.Join(dir, "b", ".env")
+
+	store := fakeStore{}
+	require.NoError(t, store.Set(Service, account(first, "ADMIN_PRIVATE_KEY"), "suiprivkey1aaa"))
+
+	assert.Equal(t, "suiprivkey1aaa", Lookup(store, first, "ADMIN_PRIVATE_KEY"))
+	assert.Empty(t, Lookup(store, second, "ADMIN_PRIVATE_KEY"))
+}
+
+func TestOverlay_FillsOnlyBlankPrivateKeys(t *testing.T) {
+	envPath := filepath.Join(t.TempDir(), ".env")
+	store := fakeStore{}
+	require.NoError(t, store.Set(Service, account(envPath, "ADMIN_PRIVATE_KEY"), "suiprivkey1aaa"))
+	require.NoError(t, store.Set(Service, account(envPath, "PLAYER_A_PRIVATE_KEY"), "suiprivkey1stale"))
+
+	env := map[string]string{
+		"ADMIN_PRIVATE_KEY":    "",
+		"PLAYER_A_PRIVATE_KEY": "suiprivkey1bbb",
+		"ADMIN_ADDRESS":        "",
+	}
+	Overlay(store, envPath, env)
+
+	assert.Equal(t, "suiprivkey1aaa", env["ADMIN_PRIVATE_KEY"])
+	assert.Equal(t, "suiprivkey1bbb", env["PLAYER_A_PRIVATE_KEY"])
+	assert.Empty(t, env["ADMIN_ADDRESS"])
+}
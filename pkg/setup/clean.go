@@ -6,10 +6,10 @@ import (
 )
 
 // CleanEnvironment stops containers, removes them, cleans up images, and volumes
-func CleanEnvironment(c container.ContainerClient) error {
+func CleanEnvironment(c container.ContainerClient, opts container.CleanupOptions) error {
 	ui.Info.Println("Cleaning up environment...")
 
-	if err := c.Cleanup(); err != nil {
+	if err := c.Cleanup(opts); err != nil {
 		return err
 	}
 
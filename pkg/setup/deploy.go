@@ -1,16 +1,21 @@
 package setup
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"os/exec"
+	"regexp"
+	"strings"
 
+	"efctl/pkg/config"
 	"efctl/pkg/container"
 	"efctl/pkg/ui"
 )
 
-// DeployWorld deploys the world contracts, configures the state, and spawns the Smart Gate infrastructure
-func DeployWorld(c container.ContainerClient, workspace string) error {
+// DeployWorld deploys the world contracts, configures the state, and spawns the Smart Gate infrastructure.
+// Cancelling ctx stops the deploy script running in the container.
+func DeployWorld(ctx context.Context, c container.ContainerClient, workspace string) error {
 	ui.Info.Println("Deploying world contracts...")
 
 	if !c.ContainerRunning(container.ContainerSuiPlayground) {
@@ -38,7 +43,7 @@ func DeployWorld(c container.ContainerClient, workspace string) error {
 	CleanStaleMoveLocks(workspace)
 
 	// 1. Generate environment
-	if err := c.Exec(context.Background(), container.ContainerSuiPlayground, []string{"/bin/bash", ScriptGenerateWorldEnv}); err != nil {
+	if err := c.Exec(ctx, container.ContainerSuiPlayground, []string{"/bin/bash", ScriptGenerateWorldEnv}); err != nil {
 		// Log all containers for debugging if this fails
 		ui.Warn.Println("Command failed, listing all containers for diagnostics:")
 		debugCmd := exec.Command(c.GetEngine(), "ps", "-a") // #nosec G204
@@ -48,15 +53,24 @@ func DeployWorld(c container.ContainerClient, workspace string) error {
 		return fmt.Errorf("failed to generate world env: %w", err)
 	}
 	ensureWorldSponsorAddresses(c, container.ContainerSuiPlayground)
+	if err := checkWorldEnv(ctx, c, config.Loaded.GetWorldEnvRequired()); err != nil {
+		return err
+	}
 
-	// 2. Install dependencies & deploy
-	if err := c.Exec(context.Background(), container.ContainerSuiPlayground, []string{"/bin/bash", "-c", CmdDeployWorld}); err != nil {
+	// 2. Install dependencies & deploy. The output is kept so a failure can
+	//    be diagnosed from this run rather than a deploy.log left by an
+	//    earlier one.
+	var deployOut bytes.Buffer
+	if err := c.ExecTee(ctx, container.ContainerSuiPlayground, []string{"/bin/bash", "-c", CmdDeployWorld}, &deployOut); err != nil {
 		// Log all containers for debugging if this fails
 		ui.Warn.Println("Command failed, listing all containers for diagnostics:")
 		debugCmd := exec.Command(c.GetEngine(), "ps", "-a") // #nosec G204
 		debugOut, _ := debugCmd.CombinedOutput()
 		fmt.Println(string(debugOut))
 
+		if hint := diagnoseMissingEnv(deployOut.String()); hint != nil {
+			return fmt.Errorf("failed to deploy world: %w: %w", hint, err)
+		}
 		return fmt.Errorf("failed to deploy world: %w", err)
 	}
 
@@ -65,7 +79,7 @@ func DeployWorld(c container.ContainerClient, workspace string) error {
 	// We handle both names during publication detection instead.
 
 	// 4. Configure World State
-	if err := c.Exec(context.Background(), container.ContainerSuiPlayground, []string{"/bin/bash", "-c", CmdConfigureWorld}); err != nil {
+	if err := c.Exec(ctx, container.ContainerSuiPlayground, []string{"/bin/bash", "-c", CmdConfigureWorld}); err != nil {
 		// Log all containers for debugging if this fails
 		ui.Warn.Println("Command failed, listing all containers for diagnostics:")
 		debugCmd := exec.Command(c.GetEngine(), "ps", "-a") // #nosec G204
@@ -77,7 +91,7 @@ func DeployWorld(c container.ContainerClient, workspace string) error {
 
 	// 5. Spawn Structures
 	ui.Info.Println("Spawning game structures (Gates)...")
-	if err := c.Exec(context.Background(), container.ContainerSuiPlayground, []string{"/bin/bash", "-c", CmdCreateTestResources}); err != nil {
+	if err := c.Exec(ctx, container.ContainerSuiPlayground, []string{"/bin/bash", "-c", CmdCreateTestResources}); err != nil {
 		// Log all containers for debugging if this fails
 		ui.Warn.Println("Command failed, listing all containers for diagnostics:")
 		debugCmd := exec.Command(c.GetEngine(), "ps", "-a") // #nosec G204
@@ -90,6 +104,75 @@ func DeployWorld(c container.ContainerClient, workspace string) error {
 	return nil
 }
 
+// requiredVarRe matches the "X is required" errors the world-contracts deploy
+// scripts raise when an environment variable is missing or empty.
+var requiredVarRe = regexp.MustCompile(`\b([A-Z][A-Z0-9_]*) is required\b`)
+
+// requiredVarHints maps variables the deploy is known to require to
+// remediation text. Variables not listed get a generic hint.
+var requiredVarHints = map[string]string{
+	"ADMIN_ADDRESS":     "it is written by generate-world-env.sh; run `efctl env down` and `efctl env up` to regenerate world-contracts/.env",
+	"ADMIN_PRIVATE_KEY": "it is written by generate-world-env.sh; run `efctl env down` and `efctl env up` to regenerate world-contracts/.env",
+	"SPONSOR_ADDRESS":   "set it in world-contracts/.env, usually to the ADMIN_ADDRESS value",
+	"SPONSOR_ADDRESSES": "set it in world-contracts/.env, usually to the ADMIN_ADDRESS value (comma separated for several sponsors)",
+}
+
+// diagnoseMissingEnv scans deploy output for "X is required" errors and
+// returns a targeted error naming the missing variables, or nil when none
+// are found.
+func diagnoseMissingEnv(output string) error {
+	var missing []string
+	seen := map[string]bool{}
+	for _, m := range requiredVarRe.FindAllStringSubmatch(output, -1) {
+		if !seen[m[1]] {
+			seen[m[1]] = true
+			missing = append(missing, m[1])
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	return missingEnvError(missing)
+}
+
+// checkWorldEnv fails when any of the required keys is missing or empty in
+// world-contracts/.env, so the deploy does not crash on it halfway through.
+// The file is read through the sui-playground container, which owns it.
+func checkWorldEnv(ctx context.Context, c container.ContainerClient, required []string) error {
+	if len(required) == 0 {
+		return nil
+	}
+	envMap, err := ReadWorldEnv(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	var missing []string
+	for _, key := range required {
+		if strings.Trim(envMap[key], `"'`) == "" {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	return fmt.Errorf("world-contracts/.env is missing required keys (adjust world-env-required in efctl.yaml if upstream changed): %w", missingEnvError(missing))
+}
+
+// missingEnvError joins the remediation hints for the named variables.
+func missingEnvError(missing []string) error {
+	hints := make([]string, 0, len(missing))
+	for _, name := range missing {
+		hint, ok := requiredVarHints[name]
+		if !ok {
+			hint = "set it in world-contracts/.env"
+		}
+		hints = append(hints, fmt.Sprintf("deploy requires %s — %s", name, hint))
+	}
+	return fmt.Errorf("%s", strings.Join(hints, "; "))
+}
+
 // NormalizeContainerScripts ensures all .sh files in the /workspace directory
 // inside the container have LF line endings. This is a critical safety net
 // for Windows users where bind-mounted scripts might drift to CRLF.
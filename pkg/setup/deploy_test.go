@@ -0,0 +1,86 @@
+package setup
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"efctl/pkg/container"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiagnoseMissingEnv_KnownVariable(t *testing.T) {
+	output := "Deploying world...\nError: SPONSOR_ADDRESSES is required\n    at main (deploy.ts:12)\n"
+
+	err := diagnoseMissingEnv(output)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "deploy requires SPONSOR_ADDRESSES")
+	assert.Contains(t, err.Error(), "world-contracts/.env")
+}
+
+func TestDiagnoseMissingEnv_UnknownVariableAndDuplicates(t *testing.T) {
+	output := "FOO_BAR is required\nretrying\nFOO_BAR is required\nADMIN_ADDRESS is required\n"
+
+	err := diagnoseMissingEnv(output)
+	require.Error(t, err)
+	assert.Equal(t,
+		"deploy requires FOO_BAR — set it in world-contracts/.env; deploy requires ADMIN_ADDRESS — "+requiredVarHints["ADMIN_ADDRESS"],
+		err.Error())
+}
+
+func TestDiagnoseMissingEnv_NoMatch(t *testing.T) {
+	assert.NoError(t, diagnoseMissingEnv(""))
+	assert.NoError(t, diagnoseMissingEnv("error: package is required to build"))
+}
+
+func TestCheckWorldEnv(t *testing.T) {
+	mc := new(mockContainerClient)
+	expectWorldEnv(mc, "ADMIN_ADDRESS=0xabc\nADMIN_PRIVATE_KEY=suiprivkey1xyz\nSPONSOR_ADDRESSES=\"\"\n")
+	ctx := context.Background()
+
+	require.NoError(t, checkWorldEnv(ctx, mc, []string{"ADMIN_ADDRESS", "ADMIN_PRIVATE_KEY"}))
+	require.NoError(t, checkWorldEnv(ctx, mc, nil))
+
+	err := checkWorldEnv(ctx, mc, []string{"ADMIN_ADDRESS", "SPONSOR_ADDRESSES", "TENANT"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "deploy requires SPONSOR_ADDRESSES")
+	assert.Contains(t, err.Error(), "deploy requires TENANT")
+	assert.NotContains(t, err.Error(), "deploy requires ADMIN_ADDRESS")
+}
+
+func TestCheckWorldEnv_MissingFile(t *testing.T) {
+	mc := new(mockContainerClient)
+	mc.On("ExecCapture", mock.Anything, container.ContainerSuiPlayground, []string{"cat", containerEnvPath}).
+		Return("cat: /workspace/world-contracts/.env: No such file or directory", errors.New("exit status 1"))
+
+	err := checkWorldEnv(context.Background(), mc, []string{"ADMIN_ADDRESS"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to read "+containerEnvPath)
+}
+
+func TestDeployWorld_DiagnosesMissingEnvFromThisRun(t *testing.T) {
+	ws := t.TempDir()
+	// A deploy.log left by an earlier run must not be blamed.
+	logDir := filepath.Join(ws, "world-contracts", "deployments", "localnet")
+	require.NoError(t, os.MkdirAll(logDir, 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(logDir, "deploy.log"), []byte("ADMIN_ADDRESS is required\n"), 0o600))
+
+	mc := new(mockContainerClient)
+	mc.On("ContainerRunning", container.ContainerSuiPlayground).Return(true)
+	mc.On("GetEngine").Return("")
+	mc.On("Exec", mock.Anything, container.ContainerSuiPlayground, mock.Anything).Return(nil)
+	mc.On("ExecCapture", mock.Anything, container.ContainerSuiPlayground, []string{"cat", containerEnvPath}).
+		Return("ADMIN_ADDRESS=0xabc\nADMIN_PRIVATE_KEY=suiprivkey1xyz\nSPONSOR_ADDRESS=0xabc\nSPONSOR_ADDRESSES=0xabc\n", nil)
+	mc.On("ExecTee", mock.Anything, container.ContainerSuiPlayground, []string{"/bin/bash", "-c", CmdDeployWorld}, mock.Anything).
+		Return(errors.New("exit status 1"), "Error: TENANT is required\n")
+
+	err := DeployWorld(context.Background(), mc, ws)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "deploy requires TENANT")
+	assert.NotContains(t, err.Error(), "deploy requires ADMIN_ADDRESS")
+}
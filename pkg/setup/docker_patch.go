@@ -41,12 +41,52 @@ func warnPatchUnmatched(op, target string) {
 	ui.Warn.Printf("patch: %s — target not found in %s", op, target)
 }
 
+// EfctlVersion is recorded in the headers and markers efctl writes into files
+// it generates or patches. cmd sets it to the build version at startup.
+var EfctlVersion = "dev"
+
+// efctlGeneratedMarker starts the header of every file efctl generates. Files
+// without it belong to the user and are never deleted or overwritten.
+const efctlGeneratedMarker = "# Generated by efctl"
+
+// efctlPatchedMarker starts the comment efctl adds to upstream files it
+// patches in place, so unexpected diffs in the cloned repositories can be
+// traced back to efctl.
+const efctlPatchedMarker = "# Patched by efctl"
+
+// generatedHeader returns the first line efctl writes to files it generates.
+func generatedHeader() string {
+	return fmt.Sprintf("%s %s — do not edit\n", efctlGeneratedMarker, EfctlVersion)
+}
+
+// dockerfileDirective matches Dockerfile parser directives such as
+// "# syntax=...", which must stay ahead of any other comment.
+var dockerfileDirective = regexp.MustCompile(`^#\s*[A-Za-z]+\s*=`)
+
+// stampPatchMarker records that efctl patched content. The marker goes after
+// a shebang or Dockerfile parser directives and replaces any marker from an
+// earlier run, so re-patching stays idempotent.
+func stampPatchMarker(content string) string {
+	lines := strings.Split(content, "\n")
+	kept := make([]string, 0, len(lines)+1)
+	for _, line := range lines {
+		if !strings.HasPrefix(line, efctlPatchedMarker) {
+			kept = append(kept, line)
+		}
+	}
+
+	insertAt := 0
+	for insertAt < len(kept) && (strings.HasPrefix(kept[insertAt], "#!") || dockerfileDirective.MatchString(kept[insertAt])) {
+		insertAt++
+	}
+	marker := fmt.Sprintf("%s %s — re-applied on every efctl env up", efctlPatchedMarker, EfctlVersion)
+	kept = append(kept[:insertAt], append([]string{marker}, kept[insertAt:]...)...)
+	return strings.Join(kept, "\n")
+}
+
 func prepareDockerEnvironment(dockerDir string, engine string, withGraphql bool, withFrontend bool) error {
 	// Clean up any stale compose override files from older efctl versions.
-	overridePath := filepath.Join(dockerDir, "docker-compose.override.yml")
-	if err := os.Remove(overridePath); err != nil && !os.IsNotExist(err) {
-		log.Printf("cleanup: failed to remove legacy override file: %v", err)
-	}
+	cleanupLegacyOverride(filepath.Join(dockerDir, "docker-compose.override.yml"))
 
 	// Patch Dockerfile (add postgresql-client, sed safety net)
 	patchDockerfile(dockerDir)
@@ -57,6 +97,44 @@ func prepareDockerEnvironment(dockerDir string, engine string, withGraphql bool,
 	return nil
 }
 
+// cleanupLegacyOverride removes a docker-compose.override.yml written by efctl.
+// Any other override may be the user's own, so it is moved to a .bak file with
+// a warning instead of being deleted; an existing backup is never overwritten.
+func cleanupLegacyOverride(overridePath string) {
+	content, err := os.ReadFile(overridePath) // #nosec G304 -- path is workspace-local and constructed by caller
+	if err != nil {
+		return
+	}
+
+	if isLegacyEfctlOverride(string(content)) {
+		if err := os.Remove(overridePath); err != nil {
+			log.Printf("cleanup: failed to remove legacy override file %s: %v", overridePath, err)
+		}
+		return
+	}
+
+	backupPath := overridePath + ".bak"
+	if _, err := os.Stat(backupPath); err == nil {
+		ui.Warn.Printfln("%s was not generated by efctl and %s already exists; leaving it in place", overridePath, backupPath)
+		return
+	}
+	if err := os.Rename(overridePath, backupPath); err != nil {
+		ui.Warn.Printfln("Could not back up %s: %v", overridePath, err)
+		return
+	}
+	ui.Warn.Printfln("%s was not generated by efctl; moved it to %s. efctl starts its containers directly and does not use compose overrides.", overridePath, backupPath)
+}
+
+// isLegacyEfctlOverride recognises the compose override older efctl versions
+// wrote to enable optional services. Those files carried no header, so they are
+// identified by the postgres service or the SUI_GRAPHQL_ENABLED variable they
+// always contained, the same check the dashboard used to read them.
+func isLegacyEfctlOverride(content string) bool {
+	return strings.HasPrefix(content, efctlGeneratedMarker) ||
+		strings.Contains(content, "postgres:") ||
+		strings.Contains(content, "SUI_GRAPHQL_ENABLED")
+}
+
 func patchDockerfile(dockerDir string) {
 	dockerfilePath, err := safePath(dockerDir, "Dockerfile")
 	if err != nil {
@@ -127,6 +205,7 @@ func patchDockerfile(dockerDir string) {
 	} else {
 		warnPatchUnmatched("sed-safety-net", "Dockerfile")
 	}
+	content = stampPatchMarker(content)
 	if err := os.WriteFile(dockerfilePath, []byte(content), 0600); err != nil { // #nosec G304 G703 -- path validated by safePath; error is handled via log.Printf below
 		log.Printf("patch: failed to write Dockerfile: %v", err)
 	}
@@ -160,6 +239,7 @@ func patchEntrypoint(dockerDir string) {
 		content = strings.ReplaceAll(content, bindMountEnvPath, "/workspace/.sui/.env.sui")
 	}
 
+	content = stampPatchMarker(content)
 	if err := os.WriteFile(entrypointPath, []byte(content), 0700); err != nil { // #nosec G302 G306 G703 -- entrypoint.sh must be executable; path validated by safePath; error is handled via log.Printf below
 		log.Printf("patch: failed to write entrypoint.sh: %v", err)
 	}
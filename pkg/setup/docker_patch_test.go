@@ -131,6 +131,82 @@ func TestPrepareDockerEnvironment_CleansUpStaleOverride(t *testing.T) {
 	assert.True(t, os.IsNotExist(err), "stale override file should be removed")
 }
 
+func TestCleanupLegacyOverride_RemovesGraphqlOverride(t *testing.T) {
+	dir := t.TempDir()
+	overridePath := filepath.Join(dir, "docker-compose.override.yml")
+	legacy := "services:\n  sui-playground:\n    environment:\n      - SUI_GRAPHQL_ENABLED=true\n"
+	require.NoError(t, os.WriteFile(overridePath, []byte(legacy), 0600))
+
+	cleanupLegacyOverride(overridePath)
+
+	_, err := os.Stat(overridePath)
+	assert.True(t, os.IsNotExist(err), "legacy efctl override should be removed")
+	_, err = os.Stat(overridePath + ".bak")
+	assert.True(t, os.IsNotExist(err), "legacy efctl override should not be backed up")
+}
+
+func TestCleanupLegacyOverride_BacksUpUserOverride(t *testing.T) {
+	dir := t.TempDir()
+	overridePath := filepath.Join(dir, "docker-compose.override.yml")
+	userContent := "services:\n  my-tool:\n    image: busybox\n"
+	require.NoError(t, os.WriteFile(overridePath, []byte(userContent), 0600))
+
+	cleanupLegacyOverride(overridePath)
+
+	_, err := os.Stat(overridePath)
+	assert.True(t, os.IsNotExist(err))
+	backup, err := os.ReadFile(overridePath + ".bak")
+	require.NoError(t, err)
+	assert.Equal(t, userContent, string(backup))
+}
+
+func TestCleanupLegacyOverride_KeepsExistingBackup(t *testing.T) {
+	dir := t.TempDir()
+	overridePath := filepath.Join(dir, "docker-compose.override.yml")
+	require.NoError(t, os.WriteFile(overridePath, []byte("services: {}\n"), 0600))
+	require.NoError(t, os.WriteFile(overridePath+".bak", []byte("older backup\n"), 0600))
+
+	cleanupLegacyOverride(overridePath)
+
+	current, err := os.ReadFile(overridePath)
+	require.NoError(t, err)
+	assert.Equal(t, "services: {}\n", string(current))
+	backup, err := os.ReadFile(overridePath + ".bak")
+	require.NoError(t, err)
+	assert.Equal(t, "older backup\n", string(backup))
+}
+
+// ── stampPatchMarker ───────────────────────────────────────────────
+
+func TestStampPatchMarker_AfterShebang(t *testing.T) {
+	out := stampPatchMarker("#!/usr/bin/env bash\nset -e\n")
+	lines := strings.Split(out, "\n")
+	assert.Equal(t, "#!/usr/bin/env bash", lines[0])
+	assert.True(t, strings.HasPrefix(lines[1], efctlPatchedMarker))
+	assert.Equal(t, "set -e", lines[2])
+}
+
+func TestStampPatchMarker_AfterDockerfileDirectives(t *testing.T) {
+	out := stampPatchMarker("# syntax=docker/dockerfile:1\nFROM ubuntu:24.04\n")
+	lines := strings.Split(out, "\n")
+	assert.Equal(t, "# syntax=docker/dockerfile:1", lines[0])
+	assert.True(t, strings.HasPrefix(lines[1], efctlPatchedMarker))
+}
+
+func TestStampPatchMarker_ReplacesEarlierMarker(t *testing.T) {
+	orig := EfctlVersion
+	defer func() { EfctlVersion = orig }()
+
+	EfctlVersion = "v0.1.0"
+	first := stampPatchMarker("FROM ubuntu:24.04\n")
+	EfctlVersion = "v0.2.0"
+	second := stampPatchMarker(first)
+
+	assert.Equal(t, 1, strings.Count(second, efctlPatchedMarker))
+	assert.Contains(t, second, "v0.2.0")
+	assert.Equal(t, second, stampPatchMarker(second), "stamping must be idempotent")
+}
+
 // ── patchEntrypointEnvPath ─────────────────────────────────────────
 
 func TestPatchEntrypointEnvPath_DoubleQuoted(t *testing.T) {
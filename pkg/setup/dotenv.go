@@ -0,0 +1,86 @@
+package setup
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ParseDotEnv reads KEY=VALUE pairs from a .env file, skipping blank lines
+// and comments.
+func ParseDotEnv(path string) (map[string]string, error) {
+	file, err := os.Open(path) // #nosec G304
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return parseDotEnv(file)
+}
+
+// parseDotEnv reads KEY=VALUE pairs from r, as ParseDotEnv does for a file.
+func parseDotEnv(r io.Reader) (map[string]string, error) {
+	envMap := make(map[string]string)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		parts := strings.SplitN(line, "=", 2)
+		if len(parts) == 2 {
+			envMap[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
+		}
+	}
+	return envMap, scanner.Err()
+}
+
+// UpdateEnvFile sets the given keys in a .env file, keeping comments and the
+// order of existing lines and appending keys that are not present yet.
+func UpdateEnvFile(path string, updates map[string]string) error {
+	content, err := os.ReadFile(path) // #nosec G304
+	if err != nil {
+		return err
+	}
+
+	cleanPath := filepath.Clean(path)
+	return os.WriteFile(cleanPath, []byte(updateEnvContent(string(content), updates)), 0600) // #nosec G306 G703 -- path is constructed from workspace-local filepath.Join in caller
+}
+
+// updateEnvContent returns the .env content with the given keys set, as
+// UpdateEnvFile writes it.
+func updateEnvContent(content string, updates map[string]string) string {
+	lines := strings.Split(content, "\n")
+	updatedMap := make(map[string]bool)
+
+	var newLines []string
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			newLines = append(newLines, line)
+			continue
+		}
+
+		parts := strings.SplitN(trimmed, "=", 2)
+		if len(parts) == 2 {
+			key := strings.TrimSpace(parts[0])
+			if val, ok := updates[key]; ok {
+				newLines = append(newLines, fmt.Sprintf("%s=%s", key, val))
+				updatedMap[key] = true
+				continue
+			}
+		}
+		newLines = append(newLines, line)
+	}
+
+	// Append any missing keys
+	for k, v := range updates {
+		if !updatedMap[k] {
+			newLines = append(newLines, fmt.Sprintf("%s=%s", k, v))
+		}
+	}
+
+	return strings.Join(newLines, "\n")
+}
@@ -0,0 +1,77 @@
+package setup
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ── ParseDotEnv ────────────────────────────────────────────────────
+
+func TestParseDotEnv(t *testing.T) {
+	content := `# comment
+FOO=bar
+BAZ = qux
+
+# another comment
+EMPTY=
+`
+	f := filepath.Join(t.TempDir(), ".env")
+	require.NoError(t, os.WriteFile(f, []byte(content), 0600))
+
+	m, err := ParseDotEnv(f)
+	require.NoError(t, err)
+	assert.Equal(t, "bar", m["FOO"])
+	assert.Equal(t, "qux", m["BAZ"])
+	assert.Equal(t, "", m["EMPTY"])
+	_, hasComment := m["# comment"]
+	assert.False(t, hasComment)
+}
+
+func TestParseDotEnv_FileNotFound(t *testing.T) {
+	_, err := ParseDotEnv("/nonexistent/.env")
+	assert.Error(t, err)
+}
+
+// ── UpdateEnvFile ──────────────────────────────────────────────────
+
+func TestUpdateEnvFile_UpdatesExistingKeys(t *testing.T) {
+	initial := "FOO=old\nBAR=keep\n"
+	f := filepath.Join(t.TempDir(), ".env")
+	require.NoError(t, os.WriteFile(f, []byte(initial), 0600))
+
+	err := UpdateEnvFile(f, map[string]string{"FOO": "new"})
+	require.NoError(t, err)
+
+	content, _ := os.ReadFile(f)
+	assert.Contains(t, string(content), "FOO=new")
+	assert.Contains(t, string(content), "BAR=keep")
+}
+
+func TestUpdateEnvFile_AppendsNewKeys(t *testing.T) {
+	initial := "FOO=val\n"
+	f := filepath.Join(t.TempDir(), ".env")
+	require.NoError(t, os.WriteFile(f, []byte(initial), 0600))
+
+	err := UpdateEnvFile(f, map[string]string{"NEW_KEY": "new_val"})
+	require.NoError(t, err)
+
+	content, _ := os.ReadFile(f)
+	assert.Contains(t, string(content), "NEW_KEY=new_val")
+	assert.Contains(t, string(content), "FOO=val")
+}
+
+func TestUpdateEnvFile_PreservesComments(t *testing.T) {
+	initial := "# This is a comment\nFOO=old\n"
+	f := filepath.Join(t.TempDir(), ".env")
+	require.NoError(t, os.WriteFile(f, []byte(initial), 0600))
+
+	err := UpdateEnvFile(f, map[string]string{"FOO": "new"})
+	require.NoError(t, err)
+
+	content, _ := os.ReadFile(f)
+	assert.Contains(t, string(content), "# This is a comment")
+}
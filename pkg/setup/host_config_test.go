@@ -26,7 +26,7 @@ func TestStartPostgresKeepsLocalHostByDefault(t *testing.T) {
 	m.On("StartContainer", mock.Anything, container.ContainerPostgres).Return(nil)
 	m.On("WaitHealthy", mock.Anything, container.ContainerPostgres, 60*time.Second).Return(nil)
 
-	require.NoError(t, startPostgres(m, context.Background(), "sui", "pass", "db"))
+	require.NoError(t, startPostgres(m, context.Background(), "pass"))
 	m.AssertExpectations(t)
 }
 
@@ -44,6 +44,47 @@ func TestStartPostgresUsesServiceHostWhenExposed(t *testing.T) {
 	m.On("StartContainer", mock.Anything, container.ContainerPostgres).Return(nil)
 	m.On("WaitHealthy", mock.Anything, container.ContainerPostgres, 60*time.Second).Return(nil)
 
-	require.NoError(t, startPostgres(m, context.Background(), "sui", "pass", "db"))
+	require.NoError(t, startPostgres(m, context.Background(), "pass"))
 	m.AssertExpectations(t)
 }
+
+func TestServiceConfigsFollowsFeatureFlags(t *testing.T) {
+	oldLoaded := config.Loaded
+	config.Loaded = &config.Config{}
+	defer func() { config.Loaded = oldLoaded }()
+
+	m := &mockContainerClient{}
+	m.On("NetworkName").Return("efctl-test")
+	m.On("GetEngine").Return("docker")
+
+	cfgs, err := ServiceConfigs(m, t.TempDir(), true, true, false, RedactedPostgresPassword)
+	require.NoError(t, err)
+	require.Len(t, cfgs, 3)
+	require.Equal(t, container.ContainerPostgres, cfgs[0].Name)
+	require.Equal(t, container.ContainerSuiPlayground, cfgs[1].Name)
+	require.Equal(t, container.ContainerFrontend, cfgs[2].Name)
+	require.Contains(t, cfgs[0].Env, "POSTGRES_PASSWORD="+RedactedPostgresPassword)
+
+	cfgs, err = ServiceConfigs(m, t.TempDir(), false, false, false, RedactedPostgresPassword)
+	require.NoError(t, err)
+	require.Len(t, cfgs, 1)
+	require.Equal(t, container.ContainerSuiPlayground, cfgs[0].Name)
+}
+
+func TestServiceConfigsPassesSkipFrontendInstall(t *testing.T) {
+	oldLoaded := config.Loaded
+	config.Loaded = &config.Config{}
+	defer func() { config.Loaded = oldLoaded }()
+
+	m := &mockContainerClient{}
+	m.On("NetworkName").Return("efctl-test")
+	m.On("GetEngine").Return("docker")
+
+	install, err := ServiceConfigs(m, t.TempDir(), false, true, false, RedactedPostgresPassword)
+	require.NoError(t, err)
+	skip, err := ServiceConfigs(m, t.TempDir(), false, true, true, RedactedPostgresPassword)
+	require.NoError(t, err)
+
+	require.Len(t, skip, 2)
+	require.NotEqual(t, install[1].Cmd, skip[1].Cmd)
+}
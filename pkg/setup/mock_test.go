@@ -2,6 +2,7 @@ package setup
 
 import (
 	"context"
+	"io"
 	"time"
 
 	"efctl/pkg/container"
@@ -43,6 +44,15 @@ func (m *mockContainerClient) StopContainer(ctx context.Context, name string) er
 	return m.Called(ctx, name).Error(0)
 }
 
+func (m *mockContainerClient) ContainerHealth(name string) (string, error) {
+	args := m.Called(name)
+	return args.String(0), args.Error(1)
+}
+
+func (m *mockContainerClient) RestartContainer(ctx context.Context, name string) error {
+	return m.Called(ctx, name).Error(0)
+}
+
 func (m *mockContainerClient) RemoveContainer(ctx context.Context, name string) error {
 	return m.Called(ctx, name).Error(0)
 }
@@ -80,10 +90,22 @@ func (m *mockContainerClient) InteractiveShell(containerName string) error {
 	return m.Called(containerName).Error(0)
 }
 
+func (m *mockContainerClient) ExecInteractive(containerName string, command []string) error {
+	return m.Called(containerName, command).Error(0)
+}
+
 func (m *mockContainerClient) Exec(ctx context.Context, containerName string, command []string) error {
 	return m.Called(ctx, containerName, command).Error(0)
 }
 
+func (m *mockContainerClient) ExecTee(ctx context.Context, containerName string, command []string, w io.Writer) error {
+	args := m.Called(ctx, containerName, command, w)
+	if out, ok := args.Get(1).(string); ok {
+		_, _ = io.WriteString(w, out)
+	}
+	return args.Error(0)
+}
+
 func (m *mockContainerClient) ExecCapture(ctx context.Context, containerName string, command []string) (string, error) {
 	args := m.Called(ctx, containerName, command)
 	return args.String(0), args.Error(1)
@@ -93,8 +115,8 @@ func (m *mockContainerClient) RemoveImages(names []string) {
 	m.Called(names)
 }
 
-func (m *mockContainerClient) Cleanup() error {
-	return m.Called().Error(0)
+func (m *mockContainerClient) Cleanup(opts container.CleanupOptions) error {
+	return m.Called(opts).Error(0)
 }
 
 // mockGitClient is a local testify mock of git.GitClient
@@ -103,12 +125,17 @@ type mockGitClient struct {
 	mock.Mock
 }
 
-func (m *mockGitClient) CloneRepository(url, dest string) error {
-	return m.Called(url, dest).Error(0)
+func (m *mockGitClient) CloneRepository(ctx context.Context, url, dest string) error {
+	return m.Called(ctx, url, dest).Error(0)
 }
 
-func (m *mockGitClient) CheckoutRef(repoDir, ref string) error {
-	return m.Called(repoDir, ref).Error(0)
+func (m *mockGitClient) CheckoutRef(ctx context.Context, repoDir, ref string) error {
+	return m.Called(ctx, repoDir, ref).Error(0)
+}
+
+func (m *mockGitClient) HeadCommit(repoDir string) (string, error) {
+	args := m.Called(repoDir)
+	return args.String(0), args.Error(1)
 }
 
 func (m *mockGitClient) SetupWorkDir(workspace string) error {
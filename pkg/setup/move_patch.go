@@ -101,8 +101,10 @@ func removeManifestSection(filePath string, sectionName string) (bool, error) {
 		return false, nil
 	}
 
+	// TOML shares the # comment syntax, so the patch marker can be stamped as-is.
 	updated = trimRepeatedBlankLines(updated)
-	if err := os.WriteFile(filePath, []byte(strings.Join(updated, "\n")), 0600); err != nil {
+	patched := stampPatchMarker(strings.Join(updated, "\n"))
+	if err := os.WriteFile(filePath, []byte(patched), 0600); err != nil {
 		return false, fmt.Errorf("failed to write %s: %w", filePath, err)
 	}
 
@@ -131,6 +131,7 @@ custom_extension = "0x0"
 	smartGateData, err := os.ReadFile(filepath.Join(smartGateDir, "Move.toml"))
 	require.NoError(t, err)
 	assert.NotContains(t, string(smartGateData), "[addresses]")
+	assert.True(t, strings.HasPrefix(string(smartGateData), efctlPatchedMarker), "patched Move.toml should start with the efctl marker")
 
 	storageUnitData, err := os.ReadFile(filepath.Join(storageUnitDir, "Move.toml"))
 	require.NoError(t, err)
@@ -140,6 +141,11 @@ custom_extension = "0x0"
 	customData, err := os.ReadFile(filepath.Join(customDir, "Move.toml"))
 	require.NoError(t, err)
 	assert.Equal(t, customManifest, string(customData))
+
+	require.NoError(t, PatchBuilderExampleMoveTomls(workspace))
+	again, err := os.ReadFile(filepath.Join(smartGateDir, "Move.toml"))
+	require.NoError(t, err)
+	assert.Equal(t, string(smartGateData), string(again), "re-patching must not add a second marker")
 }
 
 func TestPatchBuilderExampleMoveTomls_IgnoresMissingExamplePackages(t *testing.T) {
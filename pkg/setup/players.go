@@ -0,0 +1,98 @@
+package setup
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"efctl/pkg/container"
+	"efctl/pkg/sui"
+	"efctl/pkg/ui"
+)
+
+// playerKeyRegex matches the .env keys of a single-letter player, e.g. PLAYER_B_ADDRESS.
+var playerKeyRegex = regexp.MustCompile(`^PLAYER_([A-Z])_(?:ADDRESS|PRIVATE_KEY)$`)
+
+// maxPlayerLetter is the last PLAYER_<letter> prefix AddPlayers hands out.
+const maxPlayerLetter = 'Z'
+
+// PlayerAccount is a test account created by AddPlayers.
+type PlayerAccount struct {
+	Role    string // e.g. "Player C"
+	Alias   string // sui keystore alias, e.g. "ef-player-c"
+	Address string
+	Funded  bool // the faucet accepted the gas request
+}
+
+// AddPlayers generates n Ed25519 accounts, records them in
+// world-contracts/.env as PLAYER_<letter>_ADDRESS and PLAYER_<letter>_PRIVATE_KEY
+// after the last player already there, imports them into the sui keystore when
+// sui is installed, and requests gas for each from faucetURL. The .env is read
+// and written through the sui-playground container, which owns it. Import and
+// faucet failures are reported as warnings; the accounts are kept.
+func AddPlayers(ctx context.Context, c container.ContainerClient, workspace, faucetURL string, n int) ([]PlayerAccount, error) {
+	env, err := ReadWorldEnv(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	prefixes, err := nextPlayerPrefixes(env, n)
+	if err != nil {
+		return nil, err
+	}
+
+	players := make([]PlayerAccount, 0, n)
+	var lines []string
+	for _, prefix := range prefixes {
+		key, addr, err := sui.GenerateEd25519Key()
+		if err != nil {
+			return nil, err
+		}
+		role, alias := sui.RoleForEnvPrefix(prefix)
+		players = append(players, PlayerAccount{Role: role, Alias: alias, Address: addr})
+		lines = append(lines, prefix+"_ADDRESS="+addr, prefix+"_PRIVATE_KEY="+key)
+	}
+	if err := AppendWorldEnv(ctx, c, lines); err != nil {
+		return nil, err
+	}
+
+	if sui.IsSuiInstalled() {
+		if summary, err := sui.ImportWorkspaceKeys(workspace); err != nil {
+			ui.Warn.Println("Could not import the new keys into the sui keystore: " + err.Error())
+		} else {
+			ui.Debug.Println("Sui keystore: " + summary.String())
+		}
+	} else {
+		ui.Warn.Println("sui CLI not found; the new keys were not imported into the sui keystore.")
+	}
+
+	for i := range players {
+		if err := sui.RequestFaucet(faucetURL, players[i].Address); err != nil {
+			ui.Warn.Printfln("Could not fund %s: %v", players[i].Role, err)
+			continue
+		}
+		players[i].Funded = true
+	}
+	return players, nil
+}
+
+// nextPlayerPrefixes returns n PLAYER_<letter> prefixes following the highest
+// single-letter player in env, so PLAYER_A and PLAYER_B yield PLAYER_C onwards.
+func nextPlayerPrefixes(env map[string]string, n int) ([]string, error) {
+	if n < 1 {
+		return nil, fmt.Errorf("number of players must be at least 1, got %d", n)
+	}
+	last := 'A' - 1
+	for key := range env {
+		if m := playerKeyRegex.FindStringSubmatch(key); m != nil {
+			last = max(last, rune(m[1][0]))
+		}
+	}
+	if int(maxPlayerLetter-last) < n {
+		return nil, fmt.Errorf("cannot add %d players: only %d of PLAYER_A to PLAYER_%c are free", n, maxPlayerLetter-last, maxPlayerLetter)
+	}
+	prefixes := make([]string, n)
+	for i := range prefixes {
+		prefixes[i] = fmt.Sprintf("PLAYER_%c", last+1+rune(i))
+	}
+	return prefixes, nil
+}
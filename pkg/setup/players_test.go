@@ -0,0 +1,93 @@
+package setup
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"efctl/pkg/sui"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNextPlayerPrefixes(t *testing.T) {
+	env := map[string]string{
+		"ADMIN_ADDRESS":        "0x1",
+		"PLAYER_A_ADDRESS":     "0x2",
+		"PLAYER_B_PRIVATE_KEY": "",
+		"PLAYER_BOSS_ADDRESS":  "0x3",
+	}
+
+	prefixes, err := nextPlayerPrefixes(env, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"PLAYER_C", "PLAYER_D"}, prefixes)
+
+	prefixes, err = nextPlayerPrefixes(map[string]string{}, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"PLAYER_A"}, prefixes)
+}
+
+func TestNextPlayerPrefixes_Limits(t *testing.T) {
+	_, err := nextPlayerPrefixes(map[string]string{}, 0)
+	assert.Error(t, err)
+
+	_, err = nextPlayerPrefixes(map[string]string{"PLAYER_Y_ADDRESS": "0x1"}, 2)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "only 1 of PLAYER_A to PLAYER_Z are free")
+}
+
+func TestAddPlayers_RecordsAndFundsAccounts(t *testing.T) {
+	t.Setenv("PATH", t.TempDir())
+	mc := new(mockContainerClient)
+	written := expectWorldEnv(mc, "# world\nPLAYER_A_ADDRESS=0x1\nPLAYER_B_ADDRESS=0x2")
+
+	var funded []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req sui.FaucetRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err == nil {
+			funded = append(funded, req.FixedAmountRequest.Recipient)
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer srv.Close()
+
+	players, err := AddPlayers(context.Background(), mc, t.TempDir(), srv.URL, 2)
+	require.NoError(t, err)
+	require.Len(t, players, 2)
+	assert.Equal(t, "Player C", players[0].Role)
+	assert.Equal(t, "ef-player-d", players[1].Alias)
+	assert.True(t, players[0].Funded)
+	assert.Equal(t, []string{players[0].Address, players[1].Address}, funded)
+
+	env, err := parseDotEnv(strings.NewReader(*written))
+	require.NoError(t, err)
+	assert.Equal(t, "0x2", env["PLAYER_B_ADDRESS"])
+	assert.Equal(t, players[0].Address, env["PLAYER_C_ADDRESS"])
+	addr, err := sui.DeriveAddressFromPrivateKey(env["PLAYER_D_PRIVATE_KEY"])
+	require.NoError(t, err)
+	assert.Equal(t, players[1].Address, addr)
+}
+
+func TestAddPlayers_KeepsAccountsWhenFaucetFails(t *testing.T) {
+	t.Setenv("PATH", t.TempDir())
+	mc := new(mockContainerClient)
+	written := expectWorldEnv(mc, "PLAYER_A_ADDRESS=0x1\n")
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	players, err := AddPlayers(context.Background(), mc, t.TempDir(), srv.URL, 1)
+	require.NoError(t, err)
+	require.Len(t, players, 1)
+	assert.False(t, players[0].Funded)
+
+	env, err := parseDotEnv(strings.NewReader(*written))
+	require.NoError(t, err)
+	assert.Equal(t, players[0].Address, env["PLAYER_B_ADDRESS"])
+}
@@ -35,7 +35,7 @@ func patchPnpmDependencies(workspace string) error {
 		// This is the only supported location for build-related settings
 		// in pnpm v10.26+ (allowBuilds replaces onlyBuiltDependencies in v11).
 		if err := patchPnpmWorkspaceYaml(workspacePath); err != nil {
-			errs = append(errs, fmt.Errorf("patch pnpm-workspace.yaml in %s: %w", repo, err))
+			errs = append(errs, fmt.Errorf("patch %s: %w", workspacePath, err))
 		}
 
 		// Update package.json engines configuration in repo
@@ -43,7 +43,7 @@ func patchPnpmDependencies(workspace string) error {
 		if err := patchEnginesInPackageJSON(packageJsonPath); err != nil {
 			// Don't fail if package.json doesn't exist, might be added later or in different branch
 			if !os.IsNotExist(err) {
-				errs = append(errs, fmt.Errorf("patch package.json engines in %s: %w", repo, err))
+				errs = append(errs, fmt.Errorf("patch engines in %s: %w", packageJsonPath, err))
 			}
 		}
 	}
@@ -62,7 +62,7 @@ func patchPnpmWorkspaceYaml(path string) error {
 	if err != nil {
 		if os.IsNotExist(err) {
 			// Create new file
-			content := "allowBuilds:\n  esbuild: true\n"
+			content := generatedHeader() + "allowBuilds:\n  esbuild: true\n"
 			return os.WriteFile(path, []byte(content), 0600) // #nosec G306 G703 -- path is validated by patchPnpmDependencies or provided by a test fixture; restricted permissions
 		}
 		return err
@@ -75,6 +75,9 @@ func patchPnpmWorkspaceYaml(path string) error {
 	if !changed {
 		return nil
 	}
+	if !bytes.HasPrefix(existing, []byte(efctlGeneratedMarker)) {
+		updated = []byte(stampPatchMarker(string(updated)))
+	}
 
 	return os.WriteFile(path, updated, 0600) // #nosec G306 G703 -- path is validated by patchPnpmDependencies or provided by a test fixture; restricted permissions
 }
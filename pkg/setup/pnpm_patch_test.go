@@ -32,6 +32,9 @@ func TestPatchPnpmWorkspaceYaml_CreatesNew(t *testing.T) {
 	if !strings.Contains(content, "esbuild: true") {
 		t.Errorf("pnpm-workspace.yaml should contain esbuild: true. Got:\n%s", content)
 	}
+	if !strings.HasPrefix(content, efctlGeneratedMarker) {
+		t.Errorf("generated pnpm-workspace.yaml should start with the efctl header. Got:\n%s", content)
+	}
 }
 
 func TestPatchPnpmWorkspaceYaml_AppendsToExisting(t *testing.T) {
@@ -60,6 +63,9 @@ func TestPatchPnpmWorkspaceYaml_AppendsToExisting(t *testing.T) {
 	if !strings.Contains(content, "# my workspace config") {
 		t.Errorf("original content should be preserved")
 	}
+	if !strings.HasPrefix(content, efctlPatchedMarker) {
+		t.Errorf("patched file should start with the efctl marker. Got:\n%s", content)
+	}
 	if !strings.Contains(content, "allowBuilds:") {
 		t.Errorf("should contain allowBuilds:. Got:\n%s", content)
 	}
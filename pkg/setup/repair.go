@@ -0,0 +1,108 @@
+package setup
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"efctl/pkg/git"
+)
+
+// NormalizeWorkspaceScripts strips CRLF line endings from the shell scripts in
+// the cloned repositories and returns the workspace-relative paths it changed.
+// A script with CRLF endings fails inside the container with "bash\r: No such
+// file or directory".
+func NormalizeWorkspaceScripts(workspace string) ([]string, error) {
+	var fixed []string
+	for _, repo := range []string{"builder-scaffold", "world-contracts"} {
+		root, err := resolveRepoPath(workspace, repo)
+		if err != nil {
+			return fixed, err
+		}
+		err = filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return nil
+			}
+			if info.IsDir() {
+				if info.Name() == "node_modules" || info.Name() == ".git" {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if !strings.HasSuffix(strings.ToLower(info.Name()), ".sh") {
+				return nil
+			}
+			data, err := os.ReadFile(path) // #nosec G304 -- path is found by walking the workspace repository
+			if err != nil || !bytes.Contains(data, []byte("\r")) {
+				return nil
+			}
+			if err := git.NormalizeLineEndings(path); err != nil {
+				return fmt.Errorf("normalize %s: %w", path, err)
+			}
+			fixed = append(fixed, workspaceRel(workspace, path))
+			return nil
+		})
+		if err != nil {
+			return fixed, err
+		}
+	}
+	return fixed, nil
+}
+
+// ReapplyDockerPatches runs the Dockerfile and entrypoint.sh patch pass that
+// env up applies and returns the files whose content changed, i.e. the ones
+// that had reverted to their upstream form since the last env up.
+func ReapplyDockerPatches(workspace string) ([]string, error) {
+	repoPath, err := resolveRepoPath(workspace, "builder-scaffold")
+	if err != nil {
+		return nil, err
+	}
+	dockerDir, err := safePath(repoPath, "docker")
+	if err != nil {
+		return nil, err
+	}
+	targets := []string{
+		filepath.Join(dockerDir, "Dockerfile"),
+		filepath.Join(dockerDir, "scripts", "entrypoint.sh"),
+	}
+	before := make(map[string][]byte, len(targets))
+	for _, t := range targets {
+		data, err := os.ReadFile(t) // #nosec G304 -- path is constructed under the workspace docker directory
+		if err != nil {
+			return nil, fmt.Errorf("read %s: %w", t, err)
+		}
+		before[t] = data
+	}
+
+	if err := prepareDockerEnvironment(dockerDir, "", false, false); err != nil {
+		return nil, err
+	}
+
+	var changed []string
+	for _, t := range targets {
+		after, err := os.ReadFile(t) // #nosec G304 -- path is constructed under the workspace docker directory
+		if err != nil {
+			return changed, fmt.Errorf("read %s: %w", t, err)
+		}
+		if !bytes.Equal(before[t], after) {
+			changed = append(changed, workspaceRel(workspace, t))
+		}
+	}
+	return changed, nil
+}
+
+// workspaceRel returns path relative to the resolved workspace, or path itself
+// when it cannot be made relative.
+func workspaceRel(workspace, path string) string {
+	base, err := ResolveWorkspacePath(workspace)
+	if err != nil {
+		return path
+	}
+	rel, err := filepath.Rel(base, path)
+	if err != nil {
+		return path
+	}
+	return rel
+}
@@ -0,0 +1,56 @@
+package setup
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeWorkspaceScripts(t *testing.T) {
+	ws := t.TempDir()
+	scripts := filepath.Join(ws, "builder-scaffold", "docker", "scripts")
+	require.NoError(t, os.MkdirAll(scripts, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(scripts, "entrypoint.sh"), []byte("#!/usr/bin/env bash\r\necho hi\r\n"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(scripts, "clean.sh"), []byte("#!/usr/bin/env bash\n"), 0755))
+
+	fixed, err := NormalizeWorkspaceScripts(ws)
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join("builder-scaffold", "docker", "scripts", "entrypoint.sh")}, fixed)
+
+	data, err := os.ReadFile(filepath.Join(scripts, "entrypoint.sh"))
+	require.NoError(t, err)
+	assert.Equal(t, "#!/usr/bin/env bash\necho hi\n", string(data))
+
+	fixed, err = NormalizeWorkspaceScripts(ws)
+	require.NoError(t, err)
+	assert.Empty(t, fixed, "a second pass should find nothing to fix")
+}
+
+func TestReapplyDockerPatches(t *testing.T) {
+	ws := t.TempDir()
+	dockerDir := filepath.Join(ws, "builder-scaffold", "docker")
+	require.NoError(t, os.MkdirAll(filepath.Join(dockerDir, "scripts"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dockerDir, "Dockerfile"), []byte("FROM ubuntu:24.04\nENV SUI_CONFIG_DIR=/root/.sui\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dockerDir, "scripts", "entrypoint.sh"),
+		[]byte("#!/usr/bin/env bash\nENV_FILE=\"/workspace/builder-scaffold/docker/.env.sui\"\n"), 0755))
+	captureWarnings(t)
+
+	changed, err := ReapplyDockerPatches(ws)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{
+		filepath.Join("builder-scaffold", "docker", "Dockerfile"),
+		filepath.Join("builder-scaffold", "docker", "scripts", "entrypoint.sh"),
+	}, changed)
+
+	changed, err = ReapplyDockerPatches(ws)
+	require.NoError(t, err)
+	assert.Empty(t, changed, "already-patched files should be left unchanged")
+}
+
+func TestReapplyDockerPatches_MissingFiles(t *testing.T) {
+	_, err := ReapplyDockerPatches(t.TempDir())
+	assert.Error(t, err)
+}
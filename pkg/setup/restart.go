@@ -0,0 +1,76 @@
+package setup
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"efctl/pkg/container"
+	"efctl/pkg/ui"
+)
+
+// Services that can be restarted individually with RestartService.
+const (
+	ServiceFrontend = "frontend"
+	ServiceDB       = "db"
+	ServiceSui      = "sui"
+)
+
+// RestartableServices lists the service names accepted by RestartService.
+var RestartableServices = []string{ServiceFrontend, ServiceDB, ServiceSui}
+
+// restartSettleDelay is how long RestartService waits before checking that the
+// container is still running; tests shorten it.
+var restartSettleDelay = 3 * time.Second
+
+// serviceContainers returns the container names a service may run under,
+// current name first, followed by names left behind by compose-based setups.
+func serviceContainers(service string) ([]string, error) {
+	switch service {
+	case ServiceFrontend:
+		return []string{container.ContainerFrontend, container.ContainerFrontendOld, container.ContainerFrontendOld2}, nil
+	case ServiceDB:
+		return []string{container.ContainerPostgres, container.ContainerPostgresOld, container.ContainerPostgresOld2}, nil
+	case ServiceSui:
+		return []string{container.ContainerSuiPlayground}, nil
+	default:
+		return nil, fmt.Errorf("unknown service %q: must be one of %s", service, strings.Join(RestartableServices, ", "))
+	}
+}
+
+// RestartService restarts the container backing a single service and verifies
+// it is still running afterwards, printing its recent logs if it is not.
+func RestartService(c container.ContainerClient, service string) error {
+	names, err := serviceContainers(service)
+	if err != nil {
+		return err
+	}
+
+	name := names[0]
+	for _, candidate := range names {
+		if c.ContainerRunning(candidate) {
+			name = candidate
+			break
+		}
+	}
+
+	ui.Info.Printf("Restarting %s container (%s)...\n", service, name)
+	if err := c.RestartContainer(context.Background(), name); err != nil {
+		return err
+	}
+
+	// Give the container a moment to start (or crash)
+	time.Sleep(restartSettleDelay)
+
+	if !c.ContainerRunning(name) {
+		logsOut := c.ContainerLogs(name, 30)
+		if logsOut == "" || strings.Contains(logsOut, "could not retrieve") {
+			logsOut = "(no logs available)"
+		}
+		ui.Warn.Printf("%s container exited after restart. Logs:\n", name)
+		fmt.Println(logsOut)
+		return fmt.Errorf("%s container is not running — check the logs above for details", name)
+	}
+	return nil
+}
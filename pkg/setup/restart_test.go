@@ -0,0 +1,60 @@
+package setup
+
+import (
+	"testing"
+
+	"efctl/pkg/container"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func shortenRestartSettleDelay(t *testing.T) {
+	old := restartSettleDelay
+	restartSettleDelay = 0
+	t.Cleanup(func() { restartSettleDelay = old })
+}
+
+func TestRestartServiceRestartsCurrentContainer(t *testing.T) {
+	shortenRestartSettleDelay(t)
+
+	c := new(mockContainerClient)
+	c.On("ContainerRunning", container.ContainerFrontend).Return(true)
+	c.On("RestartContainer", mock.Anything, container.ContainerFrontend).Return(nil).Once()
+
+	require.NoError(t, RestartService(c, ServiceFrontend))
+	c.AssertExpectations(t)
+}
+
+func TestRestartServiceFallsBackToLegacyName(t *testing.T) {
+	shortenRestartSettleDelay(t)
+
+	c := new(mockContainerClient)
+	c.On("ContainerRunning", container.ContainerPostgres).Return(false)
+	c.On("ContainerRunning", container.ContainerPostgresOld).Return(true)
+	c.On("RestartContainer", mock.Anything, container.ContainerPostgresOld).Return(nil).Once()
+
+	require.NoError(t, RestartService(c, ServiceDB))
+	c.AssertExpectations(t)
+}
+
+func TestRestartServiceReportsContainerThatExits(t *testing.T) {
+	shortenRestartSettleDelay(t)
+
+	c := new(mockContainerClient)
+	c.On("ContainerRunning", container.ContainerSuiPlayground).Return(false)
+	c.On("RestartContainer", mock.Anything, container.ContainerSuiPlayground).Return(nil).Once()
+	c.On("ContainerLogs", container.ContainerSuiPlayground, 30).Return("boom").Once()
+
+	err := RestartService(c, ServiceSui)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "sui-playground container is not running")
+	c.AssertExpectations(t)
+}
+
+func TestRestartServiceRejectsUnknownService(t *testing.T) {
+	err := RestartService(new(mockContainerClient), "indexer")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown service "indexer"`)
+}
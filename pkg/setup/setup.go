@@ -1,6 +1,8 @@
 package setup
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -13,7 +15,9 @@ import (
 	"github.com/pterm/pterm"
 )
 
-func resolveWorkspacePath(workspace string) (string, error) {
+// ResolveWorkspacePath returns the absolute, symlink-resolved form of workspace.
+// Paths that do not exist yet are returned in absolute form only.
+func ResolveWorkspacePath(workspace string) (string, error) {
 	workspaceAbs, err := filepath.Abs(filepath.Clean(workspace))
 	if err != nil {
 		return "", fmt.Errorf("failed to resolve workspace path %s: %w", workspace, err)
@@ -31,7 +35,7 @@ func resolveRepoPath(workspace string, repoName string) (string, error) {
 		return "", fmt.Errorf("invalid repository directory name %q", repoName)
 	}
 
-	workspaceAbs, err := resolveWorkspacePath(workspace)
+	workspaceAbs, err := ResolveWorkspacePath(workspace)
 	if err != nil {
 		return "", err
 	}
@@ -68,65 +72,138 @@ func extractRepoName(url string) string {
 	return repo
 }
 
-// CloneRepositories prepares the workspace and clones required repositories
-func CloneRepositories(g git.GitClient, workspace string) error {
-	workspacePath, err := resolveWorkspacePath(workspace)
+// pinCommit checks out sha in repoPath and verifies HEAD resolved to it.
+// An empty sha leaves the repository on its configured ref.
+func pinCommit(ctx context.Context, g git.GitClient, repoPath, sha string) error {
+	if sha == "" {
+		return nil
+	}
+	ui.Info.Printfln("Pinning %s to commit %s", filepath.Base(repoPath), pterm.Bold.Sprint(sha))
+	if err := g.CheckoutRef(ctx, repoPath, sha); err != nil {
+		return err
+	}
+	head, err := g.HeadCommit(repoPath)
 	if err != nil {
 		return err
 	}
+	if !strings.HasPrefix(strings.ToLower(head), strings.ToLower(sha)) {
+		return fmt.Errorf("%s HEAD is %s after checkout, expected pinned commit %s", repoPath, head, sha)
+	}
+	return nil
+}
 
-	ui.Info.Println("Setting up workspace in " + workspacePath)
+// CloneOptions tunes how CloneRepositories handles failures.
+type CloneOptions struct {
+	// BranchFallback keeps a clone on its default branch, with a warning,
+	// when the configured ref does not exist.
+	BranchFallback bool
+	// KeepGoing attempts every repository even after one fails, reporting
+	// all failures together.
+	KeepGoing bool
+}
 
-	err = g.SetupWorkDir(workspacePath)
+// checkoutConfiguredRef checks out ref, tolerating a missing ref when
+// branchFallback is set.
+func checkoutConfiguredRef(ctx context.Context, g git.GitClient, repoPath, ref string, branchFallback bool) error {
+	err := g.CheckoutRef(ctx, repoPath, ref)
+	if err != nil && branchFallback && errors.Is(err, git.ErrRefNotFound) {
+		ui.Warn.Printfln("%v; continuing on the default branch", err)
+		return nil
+	}
+	return err
+}
+
+// CloneRepositories prepares the workspace and clones required repositories.
+// Cancelling ctx stops the git command in progress.
+func CloneRepositories(ctx context.Context, g git.GitClient, workspace string, opts CloneOptions) error {
+	workspacePath, err := ResolveWorkspacePath(workspace)
 	if err != nil {
 		return err
 	}
 
-	cfg := config.Loaded
-	worldContractsUrl := cfg.GetWorldContractsURL()
-	worldContractsRef := cfg.GetWorldContractsRef()
-	worldContractsPath, err := resolveRepoPath(workspacePath, "world-contracts")
+	ui.Info.Println("Setting up workspace in " + workspacePath)
+
+	err = g.SetupWorkDir(workspacePath)
 	if err != nil {
 		return err
 	}
-	ui.Info.Printfln("Setting up %s using ref %s", pterm.Bold.Sprint(extractRepoName(worldContractsUrl)), pterm.Bold.Sprint(worldContractsRef))
-	if err := g.CloneRepository(worldContractsUrl, worldContractsPath); err != nil {
-		return err
+
+	cfg := config.Loaded
+	repos := []repoSpec{
+		{"world-contracts", cfg.GetWorldContractsURL(), cfg.GetWorldContractsRef(), cfg.GetWorldContractsCommit()},
+		{"builder-scaffold", cfg.GetBuilderScaffoldURL(), cfg.GetBuilderScaffoldRef(), cfg.GetBuilderScaffoldCommit()},
 	}
-	if err := g.CheckoutRef(worldContractsPath, worldContractsRef); err != nil {
-		return err
+
+	var errs []error
+	for _, repo := range repos {
+		repoPath, err := setupRepository(ctx, g, workspacePath, repo, opts)
+		if err != nil {
+			if !opts.KeepGoing || ctx.Err() != nil {
+				return err
+			}
+			ui.Error.Printfln("Failed to set up %s: %v", repo.dir, err)
+			errs = append(errs, fmt.Errorf("%s: %w", repo.dir, err))
+			continue
+		}
+		normalizeScripts(repoPath)
 	}
+	return errors.Join(errs...)
+}
+
+// repoSpec describes one repository CloneRepositories sets up.
+type repoSpec struct {
+	dir, url, ref, commit string
+}
 
-	builderScaffoldUrl := cfg.GetBuilderScaffoldURL()
-	builderScaffoldRef := cfg.GetBuilderScaffoldRef()
-	builderScaffoldPath, err := resolveRepoPath(workspacePath, "builder-scaffold")
+// setupRepository clones repo into the workspace, checks out its ref, and
+// pins its commit, returning the checkout path.
+func setupRepository(ctx context.Context, g git.GitClient, workspacePath string, repo repoSpec, opts CloneOptions) (string, error) {
+	repoPath, err := resolveRepoPath(workspacePath, repo.dir)
 	if err != nil {
-		return err
+		return "", err
 	}
-	ui.Info.Printfln("Setting up %s using ref %s", pterm.Bold.Sprint(extractRepoName(builderScaffoldUrl)), pterm.Bold.Sprint(builderScaffoldRef))
-	if err := g.CloneRepository(builderScaffoldUrl, builderScaffoldPath); err != nil {
-		return err
+	ui.Info.Printfln("Setting up %s using ref %s", pterm.Bold.Sprint(extractRepoName(repo.url)), pterm.Bold.Sprint(repo.ref))
+	if err := g.CloneRepository(ctx, repo.url, repoPath); err != nil {
+		return "", err
 	}
-	if err := g.CheckoutRef(builderScaffoldPath, builderScaffoldRef); err != nil {
-		return err
+	if err := checkoutConfiguredRef(ctx, g, repoPath, repo.ref, opts.BranchFallback); err != nil {
+		return "", err
 	}
-
-	// Correct line ending drift for critical shell scripts
-	normalizeScripts := func(root string) {
-		_ = filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
-			if err != nil {
-				return nil
-			}
-			if !info.IsDir() && strings.HasSuffix(strings.ToLower(info.Name()), ".sh") {
-				ui.Debug.Printfln("Normalizing line endings for %s", path)
-				git.NormalizeLineEndings(path) // #nosec G104 -- line ending normalization is best-effort
-			}
-			return nil
-		})
+	if err := pinCommit(ctx, g, repoPath, repo.commit); err != nil {
+		return "", err
 	}
+	return repoPath, nil
+}
 
-	normalizeScripts(builderScaffoldPath)
-	normalizeScripts(worldContractsPath)
+// normalizeScripts corrects line ending drift for shell scripts under root.
+func normalizeScripts(root string) {
+	_ = filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return nil
+		}
+		if !info.IsDir() && strings.HasSuffix(strings.ToLower(info.Name()), ".sh") {
+			ui.Debug.Printfln("Normalizing line endings for %s", path)
+			git.NormalizeLineEndings(path) // #nosec G104 -- line ending normalization is best-effort
+		}
+		return nil
+	})
+}
 
+// RequireRepositories verifies that the world-contracts and builder-scaffold
+// checkouts already exist in workspace, for callers that skip cloning.
+func RequireRepositories(workspace string) error {
+	var missing []string
+	for _, repo := range []string{"world-contracts", "builder-scaffold"} {
+		repoPath, err := resolveRepoPath(workspace, repo)
+		if err != nil {
+			return err
+		}
+		if info, err := os.Stat(repoPath); err != nil || !info.IsDir() {
+			missing = append(missing, repo)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("repositories not found in workspace: %s", strings.Join(missing, ", "))
+	}
 	return nil
 }
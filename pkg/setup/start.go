@@ -21,8 +21,16 @@ const (
 	suiLivenessPollInterval    = 250 * time.Millisecond
 	suiLivenessPollingTimeout  = 10 * time.Second
 	suiLivenessDiagnosticLines = 30
+
+	// frontendStartRetries is how many times an exited frontend container is
+	// started again; the first pnpm install often fails transiently.
+	frontendStartRetries = 2
 )
 
+// frontendSettleDelay is how long the frontend container gets to start (or
+// crash) before it is checked.
+var frontendSettleDelay = 3 * time.Second
+
 var waitForSuiLivenessFunc = waitForSuiLiveness
 
 // startupTimeoutFromEnv returns the startup timeout, defaulting to 10 minutes.
@@ -37,7 +45,10 @@ func startupTimeoutFromEnv() time.Duration {
 }
 
 // StartEnvironment builds images and starts containers directly (no compose).
-func StartEnvironment(c container.ContainerClient, workspace string, withGraphql bool, withFrontend bool) error {
+// skipFrontendInstall makes the frontend container skip pnpm install when its
+// node_modules volume is already populated. Cancelling ctx stops the image
+// build or container wait in progress.
+func StartEnvironment(ctx context.Context, c container.ContainerClient, workspace string, withGraphql bool, withFrontend bool, skipFrontendInstall bool) error {
 	ui.Debug.Println(fmt.Sprintf("StartEnvironment: workspace=%s engine=%s graphql=%v frontend=%v", workspace, c.GetEngine(), withGraphql, withFrontend))
 	ui.Info.Println("Starting container environment...")
 
@@ -46,7 +57,6 @@ func StartEnvironment(c container.ContainerClient, workspace string, withGraphql
 	}
 
 	dockerDir := filepath.Join(workspace, "builder-scaffold", "docker")
-	ctx := context.Background()
 
 	// Patch pnpm-workspace.yaml files to allow esbuild build scripts.
 	if err := patchPnpmDependencies(workspace); err != nil {
@@ -73,8 +83,6 @@ func StartEnvironment(c container.ContainerClient, workspace string, withGraphql
 		return fmt.Errorf("failed to create sui-config volume: %w", err)
 	}
 
-	pgUser := "sui"
-	pgDB := "sui_indexer"
 	pgPass := os.Getenv("EFCTL_PG_PASSWORD")
 	if pgPass == "" {
 		var err error
@@ -86,19 +94,19 @@ func StartEnvironment(c container.ContainerClient, workspace string, withGraphql
 
 	// ── PostgreSQL (if graphql) ─────────────────────────────────────
 	if withGraphql {
-		if err := startPostgres(c, ctx, pgUser, pgPass, pgDB); err != nil {
+		if err := startPostgres(c, ctx, pgPass); err != nil {
 			return err
 		}
 	}
 
 	// ── Sui dev container ───────────────────────────────────────────
-	if err := startSuiDev(c, ctx, workspace, dockerDir, withGraphql, pgUser, pgPass, pgDB); err != nil {
+	if err := startSuiDev(c, ctx, workspace, dockerDir, withGraphql, pgPass); err != nil {
 		return err
 	}
 
 	// ── Frontend (if requested) ─────────────────────────────────────
 	if withFrontend {
-		if err := startFrontend(c, ctx, workspace); err != nil {
+		if err := startFrontend(c, ctx, workspace, skipFrontendInstall); err != nil {
 			return err
 		}
 	}
@@ -106,14 +114,56 @@ func StartEnvironment(c container.ContainerClient, workspace string, withGraphql
 	return nil
 }
 
-func startPostgres(c container.ContainerClient, ctx context.Context, user, pass, db string) error {
-	networkName := c.NetworkName()
+const (
+	pgUser = "sui"
+	pgDB   = "sui_indexer"
+)
+
+// RedactedPostgresPassword stands in for the generated postgres password when
+// ServiceConfigs is used to display the container configuration.
+const RedactedPostgresPassword = "<redacted>"
 
+// ServiceConfigs returns the container configurations StartEnvironment creates
+// for the given feature set, in start order. pgPass is substituted for the
+// postgres password; pass RedactedPostgresPassword when only displaying them.
+func ServiceConfigs(c container.ContainerClient, workspace string, withGraphql, withFrontend, skipFrontendInstall bool, pgPass string) ([]container.ContainerConfig, error) {
+	var cfgs []container.ContainerConfig
+	if withGraphql {
+		cfgs = append(cfgs, postgresConfig(c, pgPass))
+	}
+	suiCfg, err := suiDevConfig(c, workspace, withGraphql, pgPass)
+	if err != nil {
+		return nil, err
+	}
+	cfgs = append(cfgs, suiCfg)
+	if withFrontend {
+		cfgs = append(cfgs, frontendConfig(c, workspace, skipFrontendInstall))
+	}
+	return cfgs, nil
+}
+
+func postgresConfig(c container.ContainerClient, pgPass string) container.ContainerConfig {
+	return container.PostgresConfig(c.NetworkName(), pgUser, pgPass, pgDB, config.Loaded.GetPostgresHost())
+}
+
+func suiDevConfig(c container.ContainerClient, workspace string, withGraphql bool, pgPass string) (container.ContainerConfig, error) {
+	additionalMounts, err := resolveAdditionalContainerMounts(workspace)
+	if err != nil {
+		return container.ContainerConfig{}, err
+	}
+	return container.SuiDevConfig(workspace, c.NetworkName(), c.GetEngine(), withGraphql, pgUser, pgPass, pgDB, additionalMounts, config.Loaded.GetHost()), nil
+}
+
+func frontendConfig(c container.ContainerClient, workspace string, skipInstall bool) container.ContainerConfig {
+	return container.FrontendConfig(workspace, c.NetworkName(), c.GetEngine(), config.Loaded.GetHost(), config.Loaded.GetPnpmVersion(), skipInstall)
+}
+
+func startPostgres(c container.ContainerClient, ctx context.Context, pgPass string) error {
 	if err := c.CreateVolume(ctx, container.VolumePgData); err != nil {
 		return fmt.Errorf("failed to create pgdata volume: %w", err)
 	}
 
-	pgCfg := container.PostgresConfig(networkName, user, pass, db, config.Loaded.GetPostgresHost())
+	pgCfg := postgresConfig(c, pgPass)
 	if err := c.CreateContainer(ctx, pgCfg); err != nil {
 		return fmt.Errorf("failed to create postgres container: %w", err)
 	}
@@ -126,14 +176,11 @@ func startPostgres(c container.ContainerClient, ctx context.Context, user, pass,
 	return nil
 }
 
-func startSuiDev(c container.ContainerClient, ctx context.Context, workspace, dockerDir string, withGraphql bool, pgUser, pgPass, pgDB string) error {
-	networkName := c.NetworkName()
-	additionalMounts, mountErr := resolveAdditionalContainerMounts(workspace)
-	if mountErr != nil {
-		return mountErr
+func startSuiDev(c container.ContainerClient, ctx context.Context, workspace, dockerDir string, withGraphql bool, pgPass string) error {
+	suiCfg, cfgErr := suiDevConfig(c, workspace, withGraphql, pgPass)
+	if cfgErr != nil {
+		return cfgErr
 	}
-
-	suiCfg := container.SuiDevConfig(workspace, networkName, c.GetEngine(), withGraphql, pgUser, pgPass, pgDB, additionalMounts, config.Loaded.GetHost())
 	if err := c.CreateContainer(ctx, suiCfg); err != nil {
 		return fmt.Errorf("failed to create sui-playground container: %w", err)
 	}
@@ -156,12 +203,18 @@ func startSuiDev(c container.ContainerClient, ctx context.Context, workspace, do
 	defer cancel()
 
 	if err := c.WaitForLogs(logCtx, container.ContainerSuiPlayground, container.ContainerLogReadyCtx); err != nil {
-		// On timeout or failure, capture container logs for diagnostics
-		lastLogs := c.ContainerLogs(container.ContainerSuiPlayground, 50)
-		running := c.ContainerRunning(container.ContainerSuiPlayground)
-		exitCode, exitErr := c.ContainerExitCode(container.ContainerSuiPlayground)
-		return fmt.Errorf("%w (Running: %v, ExitCode: %d, ExitErr: %v)\n\nLast 50 lines of container logs:\n%s",
-			err, running, exitCode, exitErr, lastLogs)
+		// The ready banner may change between image versions; accept an RPC
+		// healthcheck that passes as proof the node is up.
+		if health, healthErr := c.ContainerHealth(container.ContainerSuiPlayground); healthErr == nil && health == "healthy" {
+			ui.Warn.Println(fmt.Sprintf("Did not see %q in the container logs, but the Sui RPC healthcheck passes; continuing.", container.ContainerLogReadyCtx))
+		} else {
+			// On timeout or failure, capture container logs for diagnostics
+			lastLogs := c.ContainerLogs(container.ContainerSuiPlayground, 50)
+			running := c.ContainerRunning(container.ContainerSuiPlayground)
+			exitCode, exitErr := c.ContainerExitCode(container.ContainerSuiPlayground)
+			return fmt.Errorf("%w (Running: %v, ExitCode: %d, ExitErr: %v)\n\nLast 50 lines of container logs:\n%s",
+				err, running, exitCode, exitErr, lastLogs)
+		}
 	}
 
 	// The container generates its internal .env.sui. We must extract it
@@ -269,16 +322,14 @@ func checkRequiredPorts(withGraphql bool, withFrontend bool) error {
 	return nil
 }
 
-func startFrontend(c container.ContainerClient, ctx context.Context, workspace string) error {
+func startFrontend(c container.ContainerClient, ctx context.Context, workspace string, skipInstall bool) error {
 	ui.Info.Println("Starting frontend dApp...")
 
-	networkName := c.NetworkName()
-
 	if err := c.CreateVolume(ctx, container.VolumeFrontendMods); err != nil {
 		return fmt.Errorf("failed to create frontend modules volume: %w", err)
 	}
 
-	feCfg := container.FrontendConfig(workspace, networkName, c.GetEngine(), config.Loaded.GetHost())
+	feCfg := frontendConfig(c, workspace, skipInstall)
 	if err := c.CreateContainer(ctx, feCfg); err != nil {
 		return fmt.Errorf("failed to create frontend container: %w", err)
 	}
@@ -286,17 +337,40 @@ func startFrontend(c container.ContainerClient, ctx context.Context, workspace s
 		return fmt.Errorf("failed to start frontend container: %w", err)
 	}
 
-	// Give the container a moment to start (or crash)
-	time.Sleep(3 * time.Second)
+	var exit string
+	for attempt := 0; ; attempt++ {
+		// Give the container a moment to start (or crash)
+		time.Sleep(frontendSettleDelay)
+
+		if c.ContainerRunning(container.ContainerFrontend) {
+			return nil
+		}
+		exit = describeExit(c, container.ContainerFrontend)
+		if attempt == frontendStartRetries {
+			break
+		}
 
-	if !c.ContainerRunning(container.ContainerFrontend) {
-		logsOut := c.ContainerLogs(container.ContainerFrontend, 30)
-		if logsOut == "" || strings.Contains(logsOut, "could not retrieve") {
-			logsOut = "(no logs available)"
+		ui.Warn.Printfln("Frontend container %s during startup; starting it again (retry %d/%d)...", exit, attempt+1, frontendStartRetries)
+		if err := c.StartContainer(ctx, container.ContainerFrontend); err != nil {
+			return fmt.Errorf("failed to restart frontend container: %w", err)
 		}
-		ui.Warn.Println("Frontend container exited immediately. Logs:")
-		fmt.Println(logsOut)
-		return fmt.Errorf("frontend container is not running — check the logs above for details")
 	}
-	return nil
+
+	logsOut := c.ContainerLogs(container.ContainerFrontend, 30)
+	if logsOut == "" || strings.Contains(logsOut, "could not retrieve") {
+		logsOut = "(no logs available)"
+	}
+	ui.Warn.Printfln("Frontend container exited %d times. Logs:", frontendStartRetries+1)
+	fmt.Println(logsOut)
+	return fmt.Errorf("frontend container is not running (%s) — check the logs above for details", exit)
+}
+
+// describeExit reports how a stopped container ended, e.g. "exited with code
+// 137", for startup failure messages.
+func describeExit(c container.ContainerClient, name string) string {
+	code, err := c.ContainerExitCode(name)
+	if err != nil {
+		return "exited"
+	}
+	return fmt.Sprintf("exited with code %d", code)
 }
@@ -73,7 +73,7 @@ func TestStartSuiDevWaitsForReadyLogAfterLiveness(t *testing.T) {
 	c.On("WaitForLogs", mock.Anything, container.ContainerSuiPlayground, container.ContainerLogReadyCtx).Return(nil).Once()
 	c.On("ExecCapture", mock.Anything, container.ContainerSuiPlayground, []string{"cat", "/workspace/.sui/.env.sui"}).Return("KEY=value\n", nil).Once()
 
-	err := startSuiDev(c, context.Background(), t.TempDir(), t.TempDir(), false, "sui", "pass", "db")
+	err := startSuiDev(c, context.Background(), t.TempDir(), t.TempDir(), false, "pass")
 
 	require.NoError(t, err)
 	c.AssertExpectations(t)
@@ -93,10 +93,102 @@ func TestStartSuiDevPropagatesLivenessFailureBeforeReadyLog(t *testing.T) {
 	c.On("StartContainer", mock.Anything, container.ContainerSuiPlayground).Return(nil).Once()
 	c.On("Exec", mock.Anything, container.ContainerSuiPlayground, mock.AnythingOfType("[]string")).Return(nil).Maybe()
 
-	err := startSuiDev(c, context.Background(), t.TempDir(), t.TempDir(), false, "sui", "pass", "db")
+	err := startSuiDev(c, context.Background(), t.TempDir(), t.TempDir(), false, "pass")
 
 	require.Error(t, err)
 	assert.True(t, strings.Contains(err.Error(), "liveness failed"))
 	c.AssertNotCalled(t, "WaitForLogs", mock.Anything, container.ContainerSuiPlayground, container.ContainerLogReadyCtx)
 	c.AssertExpectations(t)
 }
+
+func TestStartSuiDevAcceptsHealthyContainerWithoutReadyLog(t *testing.T) {
+	oldWaitForSuiLiveness := waitForSuiLivenessFunc
+	waitForSuiLivenessFunc = func(c container.ContainerClient, containerName string, gracePeriod, pollInterval, timeout time.Duration) error {
+		return nil
+	}
+	t.Cleanup(func() { waitForSuiLivenessFunc = oldWaitForSuiLiveness })
+
+	c := new(mockContainerClient)
+	c.On("NetworkName").Return("test-net")
+	c.On("GetEngine").Return("docker")
+	c.On("CreateContainer", mock.Anything, mock.AnythingOfType("container.ContainerConfig")).Return(nil).Once()
+	c.On("StartContainer", mock.Anything, container.ContainerSuiPlayground).Return(nil).Once()
+	c.On("Exec", mock.Anything, container.ContainerSuiPlayground, mock.AnythingOfType("[]string")).Return(nil).Maybe()
+	c.On("WaitForLogs", mock.Anything, container.ContainerSuiPlayground, container.ContainerLogReadyCtx).Return(context.DeadlineExceeded).Once()
+	c.On("ContainerHealth", container.ContainerSuiPlayground).Return("healthy", nil).Once()
+	c.On("ExecCapture", mock.Anything, container.ContainerSuiPlayground, []string{"cat", "/workspace/.sui/.env.sui"}).Return("KEY=value\n", nil).Once()
+
+	err := startSuiDev(c, context.Background(), t.TempDir(), t.TempDir(), false, "pass")
+
+	require.NoError(t, err)
+	c.AssertExpectations(t)
+}
+
+func TestStartSuiDevFailsWhenReadyLogMissingAndNotHealthy(t *testing.T) {
+	oldWaitForSuiLiveness := waitForSuiLivenessFunc
+	waitForSuiLivenessFunc = func(c container.ContainerClient, containerName string, gracePeriod, pollInterval, timeout time.Duration) error {
+		return nil
+	}
+	t.Cleanup(func() { waitForSuiLivenessFunc = oldWaitForSuiLiveness })
+
+	c := new(mockContainerClient)
+	c.On("NetworkName").Return("test-net")
+	c.On("GetEngine").Return("docker")
+	c.On("CreateContainer", mock.Anything, mock.AnythingOfType("container.ContainerConfig")).Return(nil).Once()
+	c.On("StartContainer", mock.Anything, container.ContainerSuiPlayground).Return(nil).Once()
+	c.On("Exec", mock.Anything, container.ContainerSuiPlayground, mock.AnythingOfType("[]string")).Return(nil).Maybe()
+	c.On("WaitForLogs", mock.Anything, container.ContainerSuiPlayground, container.ContainerLogReadyCtx).Return(context.DeadlineExceeded).Once()
+	c.On("ContainerHealth", container.ContainerSuiPlayground).Return("starting", nil).Once()
+	c.On("ContainerLogs", container.ContainerSuiPlayground, 50).Return("still booting").Once()
+	c.On("ContainerRunning", container.ContainerSuiPlayground).Return(true).Once()
+	c.On("ContainerExitCode", container.ContainerSuiPlayground).Return(0, nil).Once()
+
+	err := startSuiDev(c, context.Background(), t.TempDir(), t.TempDir(), false, "pass")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "still booting")
+	c.AssertExpectations(t)
+}
+
+func TestStartFrontendRetriesAfterTransientExit(t *testing.T) {
+	oldDelay := frontendSettleDelay
+	frontendSettleDelay = 0
+	t.Cleanup(func() { frontendSettleDelay = oldDelay })
+
+	c := new(mockContainerClient)
+	c.On("NetworkName").Return("test-net")
+	c.On("GetEngine").Return("docker")
+	c.On("CreateVolume", mock.Anything, container.VolumeFrontendMods).Return(nil).Once()
+	c.On("CreateContainer", mock.Anything, mock.AnythingOfType("container.ContainerConfig")).Return(nil).Once()
+	c.On("StartContainer", mock.Anything, container.ContainerFrontend).Return(nil).Twice()
+	c.On("ContainerRunning", container.ContainerFrontend).Return(false).Once()
+	c.On("ContainerExitCode", container.ContainerFrontend).Return(1, nil).Once()
+	c.On("ContainerRunning", container.ContainerFrontend).Return(true).Once()
+
+	err := startFrontend(c, context.Background(), t.TempDir(), false)
+
+	require.NoError(t, err)
+	c.AssertExpectations(t)
+}
+
+func TestStartFrontendFailsAfterRetriesWithLogs(t *testing.T) {
+	oldDelay := frontendSettleDelay
+	frontendSettleDelay = 0
+	t.Cleanup(func() { frontendSettleDelay = oldDelay })
+
+	c := new(mockContainerClient)
+	c.On("NetworkName").Return("test-net")
+	c.On("GetEngine").Return("docker")
+	c.On("CreateVolume", mock.Anything, container.VolumeFrontendMods).Return(nil).Once()
+	c.On("CreateContainer", mock.Anything, mock.AnythingOfType("container.ContainerConfig")).Return(nil).Once()
+	c.On("StartContainer", mock.Anything, container.ContainerFrontend).Return(nil).Times(frontendStartRetries + 1)
+	c.On("ContainerRunning", container.ContainerFrontend).Return(false).Times(frontendStartRetries + 1)
+	c.On("ContainerExitCode", container.ContainerFrontend).Return(1, nil).Times(frontendStartRetries + 1)
+	c.On("ContainerLogs", container.ContainerFrontend, 30).Return("ERR_PNPM_FETCH").Once()
+
+	err := startFrontend(c, context.Background(), t.TempDir(), false)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "frontend container is not running (exited with code 1)")
+	c.AssertExpectations(t)
+}
@@ -0,0 +1,93 @@
+package setup
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// StateFileName is the workspace-relative file that records which env up
+// phases have completed, so `env up --resume` can skip them.
+const StateFileName = ".efctl-state.json"
+
+// Phase names one step of `env up`.
+type Phase string
+
+const (
+	PhaseClone  Phase = "clone"
+	PhaseStart  Phase = "start"
+	PhaseDeploy Phase = "deploy"
+)
+
+// phaseOrder lists the env up phases in the order they run.
+var phaseOrder = []Phase{PhaseClone, PhaseStart, PhaseDeploy}
+
+// UpState is the content of <workspace>/.efctl-state.json.
+type UpState struct {
+	LastCompleted Phase     `json:"last_completed_phase"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// Done reports whether phase ran to completion. A nil state has completed
+// nothing.
+func (s *UpState) Done(phase Phase) bool {
+	if s == nil {
+		return false
+	}
+	last, want := phaseIndex(s.LastCompleted), phaseIndex(phase)
+	return last >= 0 && want >= 0 && want <= last
+}
+
+func phaseIndex(phase Phase) int {
+	for i, p := range phaseOrder {
+		if p == phase {
+			return i
+		}
+	}
+	return -1
+}
+
+// ReadUpState loads <workspace>/.efctl-state.json. A missing file yields a
+// nil state and no error.
+func ReadUpState(workspace string) (*UpState, error) {
+	path := filepath.Join(workspace, StateFileName)
+	data, err := os.ReadFile(path) // #nosec G304 -- path is filepath.Join(workspace, hardcoded file name)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var state UpState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	if phaseIndex(state.LastCompleted) < 0 {
+		return nil, fmt.Errorf("unknown phase %q in %s", state.LastCompleted, path)
+	}
+	return &state, nil
+}
+
+// MarkPhaseComplete records phase as the last completed env up phase.
+func MarkPhaseComplete(workspace string, phase Phase) error {
+	data, err := json.MarshalIndent(UpState{LastCompleted: phase, UpdatedAt: time.Now().UTC()}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode env up state: %w", err)
+	}
+	path := filepath.Join(workspace, StateFileName)
+	if err := os.WriteFile(path, append(data, '\n'), 0600); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
+
+// ClearUpState removes the phase marker. A missing marker is not an error.
+func ClearUpState(workspace string) error {
+	err := os.Remove(filepath.Join(workspace, StateFileName))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package setup
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpState_RoundTrip(t *testing.T) {
+	dir := t.TempDir()
+
+	state, err := ReadUpState(dir)
+	require.NoError(t, err)
+	assert.Nil(t, state)
+	assert.False(t, state.Done(PhaseClone))
+
+	require.NoError(t, MarkPhaseComplete(dir, PhaseStart))
+	state, err = ReadUpState(dir)
+	require.NoError(t, err)
+	require.NotNil(t, state)
+	assert.True(t, state.Done(PhaseClone))
+	assert.True(t, state.Done(PhaseStart))
+	assert.False(t, state.Done(PhaseDeploy))
+
+	require.NoError(t, ClearUpState(dir))
+	require.NoError(t, ClearUpState(dir))
+	state, err = ReadUpState(dir)
+	require.NoError(t, err)
+	assert.Nil(t, state)
+}
+
+func TestReadUpState_UnknownPhase(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, StateFileName), []byte(`{"last_completed_phase":"bogus"}`), 0600))
+
+	_, err := ReadUpState(dir)
+	assert.ErrorContains(t, err, `unknown phase "bogus"`)
+}
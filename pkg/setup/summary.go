@@ -4,46 +4,44 @@ import (
 	"bufio"
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
+	"sync"
 
+	"efctl/pkg/config"
+	"efctl/pkg/status"
 	"efctl/pkg/sui"
 	"efctl/pkg/ui"
 	"github.com/jedib0t/go-pretty/v6/table"
 	"github.com/pterm/pterm"
 )
 
-// ExtractedObjectIds represents the JSON structure from world-object-ids.json
-type ExtractedObjectIds struct {
-	Network string `json:"network"`
-	World   struct {
-		PackageId             string `json:"packageId"`
-		GovernorCap           string `json:"governorCap"`
-		ServerAddressRegistry string `json:"serverAddressRegistry"`
-		AdminAcl              string `json:"adminAcl"`
-		ObjectRegistry        string `json:"objectRegistry"`
-		EnergyConfig          string `json:"energyConfig"`
-		FuelConfig            string `json:"fuelConfig"`
-		GateConfig            string `json:"gateConfig"`
-	} `json:"world"`
-}
-
 var characterRegex = regexp.MustCompile(`Pre-computed Character ID:\s*(0x[a-fA-F0-9]+)`)
 var nwnRegex = regexp.MustCompile(`NWN Object Id:\s*(0x[a-fA-F0-9]+)`)
 var ssuRegex = regexp.MustCompile(`Storage Unit Object Id:\s*(0x[a-fA-F0-9]+)`)
 var gateRegex = regexp.MustCompile(`Gate Object Id:\s*(0x[a-fA-F0-9]+)`)
 
-var adminAddressRegex = regexp.MustCompile(`ADMIN_ADDRESS\s*=\s*["']?(0x[a-fA-F0-9]+)["']?`)
-var adminKeyRegex = regexp.MustCompile(`ADMIN_PRIVATE_KEY\s*=\s*["']?(suiprivkey[a-zA-Z0-9]+)["']?`)
-var playerAAddressRegex = regexp.MustCompile(`PLAYER_A_ADDRESS\s*=\s*["']?(0x[a-fA-F0-9]+)["']?`)
-var playerAKeyRegex = regexp.MustCompile(`PLAYER_A_PRIVATE_KEY\s*=\s*["']?(suiprivkey[a-zA-Z0-9]+)["']?`)
-var playerBAddressRegex = regexp.MustCompile(`PLAYER_B_ADDRESS\s*=\s*["']?(0x[a-fA-F0-9]+)["']?`)
-var playerBKeyRegex = regexp.MustCompile(`PLAYER_B_PRIVATE_KEY\s*=\s*["']?(suiprivkey[a-zA-Z0-9]+)["']?`)
+var roleAddressRegex = regexp.MustCompile(`^\s*(?:export\s+)?([A-Z][A-Z0-9_]*)_ADDRESS\s*=\s*["']?(0x[a-fA-F0-9]+)["']?`)
+var roleKeyRegex = regexp.MustCompile(`^\s*(?:export\s+)?([A-Z][A-Z0-9_]*)_PRIVATE_KEY\s*=\s*["']?(suiprivkey[a-zA-Z0-9]+)["']?`)
+
+// builtinRoles are the .env role prefixes that are always listed in the summary,
+// even when their key is missing.
+var builtinRoles = []string{"ADMIN", "PLAYER_A", "PLAYER_B"}
+
+// ExplorerURL returns the explorer URL for a custom network at rpcURL, built
+// from the configured explorer-url-template.
+func ExplorerURL(rpcURL string) string {
+	return strings.ReplaceAll(config.Loaded.GetExplorerURLTemplate(), config.ExplorerRPCPlaceholder, url.QueryEscape(rpcURL))
+}
 
-func PrintDeploymentSummary(workspace string) {
+// PrintDeploymentSummary prints the deployed packages, objects, and addresses,
+// followed by the URLs of the optional services that were started.
+func PrintDeploymentSummary(workspace string, withGraphql, withFrontend bool) {
 	fmt.Println()
 	ui.Info.Println("Generating Deployment Summary...")
 
@@ -92,41 +90,60 @@ func PrintDeploymentSummary(workspace string) {
 
 	fmt.Println()
 	ui.Success.Println("Explore the generated World:")
-	fmt.Println("🔗 https://custom.suiscan.xyz/custom/home/?network=http%3A%2F%2Flocalhost%3A9000")
-
-	// Check if optional services are enabled by looking at the override file
-	overridePath := filepath.Join(workspace, "builder-scaffold", "docker", "docker-compose.override.yml")
-	if data, err := os.ReadFile(overridePath); err == nil { // #nosec G304 -- path is filepath.Join(workspace, hardcoded-sub-path); workspace is set by the user's own config
-		content := string(data)
-		if strings.Contains(content, "postgres:") || strings.Contains(content, "SUI_GRAPHQL_ENABLED") {
-			fmt.Println("📊 GraphQL API:   http://localhost:9125/graphql")
-		}
-		if strings.Contains(content, "frontend:") {
-			fmt.Println("💻 Frontend dApp: http://localhost:5173")
-		}
+	fmt.Println(ui.LinkEmoji + " " + ExplorerURL("http://localhost:9000"))
+
+	if withGraphql {
+		fmt.Println(ui.GraphQLEmoji + " GraphQL API:   http://localhost:9125/graphql")
+	}
+	if withFrontend {
+		fmt.Println(ui.AppEmoji + " Frontend dApp: http://localhost:5173")
 	}
 
 	fmt.Println()
 }
 
+// worldObjectLabels gives display names to the core world objects; any other
+// keys in extracted-object-ids.json are listed after them under their raw name.
+var worldObjectLabels = []struct{ key, label string }{
+	{"governorCap", "Governor Cap"},
+	{"adminAcl", "Admin ACL"},
+	{"objectRegistry", "Object Registry"},
+}
+
 func extractWorldIds(workspace string, tPackages, tObjects table.Writer) {
-	jsonPath := filepath.Join(workspace, "world-contracts", "deployments", "localnet", "extracted-object-ids.json")
-	bytes, err := os.ReadFile(jsonPath) // #nosec G304 -- path is filepath.Join(workspace, hardcoded-sub-path); workspace is set by the user's own config
+	ids, err := status.ReadObjectIDs(workspace, "localnet")
 	if err != nil {
-		ui.Warn.Println("Could not read extracted-object-ids.json, skipping core world IDs...")
+		if os.IsNotExist(err) {
+			ui.Warn.Println("Could not read extracted-object-ids.json, skipping core world IDs...")
+		} else {
+			ui.Warn.Println("Failed to parse extracted-object-ids.json...")
+		}
 		return
 	}
+	for _, w := range ids.Warnings {
+		ui.Warn.Println(w)
+	}
 
-	var extracted ExtractedObjectIds
-	if err := json.Unmarshal(bytes, &extracted); err == nil {
-		tPackages.AppendRow(table.Row{"World Package ID", extracted.World.PackageId})
-		tObjects.AppendRows([]table.Row{
-			{"Governor Cap", extracted.World.GovernorCap},
-			{"Admin ACL", extracted.World.AdminAcl},
-			{"Object Registry", extracted.World.ObjectRegistry},
-		})
-	} else {
-		ui.Warn.Println("Failed to parse extracted-object-ids.json...")
+	if ids.PackageID != "" {
+		tPackages.AppendRow(table.Row{"World Package ID", ids.PackageID})
+	}
+
+	shown := map[string]bool{}
+	for _, l := range worldObjectLabels {
+		if id, ok := ids.Objects[l.key]; ok {
+			tObjects.AppendRow(table.Row{l.label, id})
+			shown[l.key] = true
+		}
+	}
+	extra := make([]string, 0, len(ids.Objects))
+	for key := range ids.Objects {
+		if !shown[key] {
+			extra = append(extra, key)
+		}
+	}
+	sort.Strings(extra)
+	for _, key := range extra {
+		tObjects.AppendRow(table.Row{key, ids.Objects[key]})
 	}
 }
 
@@ -162,36 +179,60 @@ type ParsedEnv struct {
 	playerAKey     string
 	playerBAddress string
 	playerBKey     string
+
+	// addresses and keys are indexed by role prefix (e.g. "PLAYER_C");
+	// keyRoles lists prefixes with a private key in file order.
+	addresses map[string]string
+	keys      map[string]string
+	keyRoles  []string
 }
 
 func parseEnvLog(scanner *bufio.Scanner) ParsedEnv {
-	var env ParsedEnv
+	env := ParsedEnv{addresses: map[string]string{}, keys: map[string]string{}}
 	for scanner.Scan() {
 		line := scanner.Text()
-		if match := adminAddressRegex.FindStringSubmatch(line); match != nil {
-			env.adminAddress = match[1]
-		} else if match := adminKeyRegex.FindStringSubmatch(line); match != nil {
-			env.adminKey = match[1]
-		} else if match := playerAAddressRegex.FindStringSubmatch(line); match != nil {
-			env.playerAAddress = match[1]
-		} else if match := playerAKeyRegex.FindStringSubmatch(line); match != nil {
-			env.playerAKey = match[1]
-		} else if match := playerBAddressRegex.FindStringSubmatch(line); match != nil {
-			env.playerBAddress = match[1]
-		} else if match := playerBKeyRegex.FindStringSubmatch(line); match != nil {
-			env.playerBKey = match[1]
+		if match := roleAddressRegex.FindStringSubmatch(line); match != nil {
+			env.addresses[match[1]] = match[2]
+		} else if match := roleKeyRegex.FindStringSubmatch(line); match != nil {
+			if _, seen := env.keys[match[1]]; !seen {
+				env.keyRoles = append(env.keyRoles, match[1])
+			}
+			env.keys[match[1]] = match[2]
 		}
 	}
+
+	env.adminAddress, env.adminKey = env.addresses["ADMIN"], env.keys["ADMIN"]
+	env.playerAAddress, env.playerAKey = env.addresses["PLAYER_A"], env.keys["PLAYER_A"]
+	env.playerBAddress, env.playerBKey = env.addresses["PLAYER_B"], env.keys["PLAYER_B"]
 	return env
 }
 
+// roles returns the built-in roles followed by any additional roles that have
+// a private key, in the order they appear in the .env file.
+func (e ParsedEnv) roles() []string {
+	roles := append([]string(nil), builtinRoles...)
+	for _, r := range e.keyRoles {
+		builtin := false
+		for _, b := range builtinRoles {
+			if r == b {
+				builtin = true
+				break
+			}
+		}
+		if !builtin {
+			roles = append(roles, r)
+		}
+	}
+	return roles
+}
+
 func extractDynamicIds(workspace string, tObjects table.Writer) []AddressInfo {
 	extractDeployLogIds(workspace, tObjects)
 	return extractEnvAddresses(workspace)
 }
 
 func extractDeployLogIds(workspace string, tObjects table.Writer) {
-	logPath := filepath.Join(workspace, "world-contracts", "deployments", "localnet", "deploy.log")
+	logPath := status.DeployLogPath(workspace)
 	file, err := os.Open(logPath) // #nosec G304 -- path is filepath.Join(workspace, hardcoded-sub-path); workspace is set by the user's own config
 	if err == nil {
 		defer file.Close()
@@ -228,65 +269,119 @@ func extractEnvAddresses(workspace string) []AddressInfo {
 		defer envFile.Close()
 		env := parseEnvLog(bufio.NewScanner(envFile))
 
-		addresses = append(addresses, deriveRoleAddress("Admin", "ef-admin", env.adminAddress, env.adminKey))
-		addresses = append(addresses, deriveRoleAddress("Player A", "ef-player-a", env.playerAAddress, env.playerAKey))
-		addresses = append(addresses, deriveRoleAddress("Player B", "ef-player-b", env.playerBAddress, env.playerBKey))
+		resolver := new(addressResolver)
+		for _, prefix := range env.roles() {
+			role, alias := sui.RoleForEnvPrefix(prefix)
+			addresses = append(addresses, deriveRoleAddress(role, alias, env.addresses[prefix], env.keys[prefix], resolver.resolve))
+		}
 	} else {
 		ui.Warn.Println("Could not read .env, skipping addresses...")
 	}
 	return addresses
 }
 
-func deriveRoleAddress(role, alias, address, key string) AddressInfo {
+// deriveRoleAddress fills in the address for a role. The .env address wins,
+// then the address derived from the private key in Go, and only then the sui
+// keystore via resolve, so the summary needs no sui CLI when the key is present.
+func deriveRoleAddress(role, alias, address, key string, resolve func(alias string) string) AddressInfo {
 	addr := address
-	if addr == "" {
-		addr = resolveAddress(alias)
-	}
 	if addr == "" && key != "" {
 		addr = deriveAddress(key)
 	}
+	if addr == "" {
+		addr = resolve(alias)
+	}
 	if addr == "" {
 		addr = "N/A"
 	}
 	return AddressInfo{Role: role, Address: addr, Key: key}
 }
 
-func resolveAddress(alias string) string {
-	if !sui.SuiConfigExists() {
+// addressResolver looks aliases up in the sui keystore. The address list and
+// the CLI version are fetched once, on first use, and shared by every alias of
+// one summary.
+type addressResolver struct {
+	once  sync.Once
+	out   []byte
+	parse func(out []byte, alias string) string
+}
+
+func (r *addressResolver) resolve(alias string) string {
+	r.once.Do(func() {
+		if !sui.SuiConfigExists() {
+			return
+		}
+		// sui client addresses --json
+		out, err := exec.Command(sui.Binary(), "client", "addresses", "--json").Output() // #nosec G204 -- binary is the user-selected sui executable
+		if err != nil {
+			return
+		}
+		version, _ := sui.Version()
+		r.out, r.parse = out, addressesParser(version)
+	})
+	if r.parse == nil {
 		return ""
 	}
+	return r.parse(r.out, alias)
+}
 
-	// sui client addresses --json
-	out, err := exec.Command("sui", "client", "addresses", "--json").Output()
-	if err != nil {
-		return ""
+func resolveAddress(alias string) string {
+	return new(addressResolver).resolve(alias)
+}
+
+// The first Sui CLI release whose `client addresses --json` prints
+// alias/address pairs instead of an address → alias map.
+const (
+	addressPairsSinceMajor = 1
+	addressPairsSinceMinor = 66
+)
+
+// addressesParser returns a `sui client addresses --json` parser that tries
+// the format of the installed CLI version first and falls back to the other,
+// since the version string is not always reliable (e.g. a custom build).
+func addressesParser(version string) func(out []byte, alias string) string {
+	first, second := parseAddressPairs, parseAddressMap
+	if atLeast, ok := sui.VersionAtLeast(version, addressPairsSinceMajor, addressPairsSinceMinor); ok && !atLeast {
+		first, second = parseAddressMap, parseAddressPairs
+	}
+	return func(out []byte, alias string) string {
+		if addr := first(out, alias); addr != "" {
+			return addr
+		}
+		return second(out, alias)
 	}
+}
 
-	// Sui 1.66 JSON structure: {"activeAddress": "...", "addresses": [["alias", "0x..."], ...]}
+// parseAddressPairs reads the Sui 1.66+ structure:
+// {"activeAddress": "...", "addresses": [["alias", "0x..."], ...]}
+func parseAddressPairs(out []byte, alias string) string {
 	var data struct {
 		Addresses [][]string `json:"addresses"`
 	}
 	if err := json.Unmarshal(out, &data); err != nil {
-		// Fallback for older versions which might return a simple map[string]string or similar
-		var fallback map[string]string
-		if err := json.Unmarshal(out, &fallback); err == nil {
-			for addr, a := range fallback {
-				if a == alias || addr == alias {
-					return addr
-				}
-			}
-		}
+		ui.Debug.Println(fmt.Sprintf("Could not parse sui client addresses output: %v", err))
 		return ""
 	}
-
 	for _, pair := range data.Addresses {
-		if len(pair) >= 2 {
-			if pair[0] == alias {
-				return pair[1]
-			}
+		if len(pair) >= 2 && pair[0] == alias {
+			return pair[1]
 		}
 	}
+	return ""
+}
 
+// parseAddressMap reads the flat address → alias map printed by older CLIs.
+func parseAddressMap(out []byte, alias string) string {
+	var data map[string]string
+	if err := json.Unmarshal(out, &data); err != nil {
+		ui.Debug.Println(fmt.Sprintf("Could not parse sui client addresses output: %v", err))
+		return ""
+	}
+	for addr, a := range data {
+		if a == alias || addr == alias {
+			return addr
+		}
+	}
 	return ""
 }
 
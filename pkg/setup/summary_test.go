@@ -2,16 +2,35 @@ package setup
 
 import (
 	"bufio"
+	"context"
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
 
+	"efctl/pkg/config"
+	"efctl/pkg/container"
+	"efctl/pkg/git"
+
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
+// ── ExplorerURL ────────────────────────────────────────────────────
+
+func TestExplorerURL(t *testing.T) {
+	oldLoaded := config.Loaded
+	defer func() { config.Loaded = oldLoaded }()
+
+	config.Loaded = nil
+	assert.Equal(t, "https://custom.suiscan.xyz/custom/home/?network=http%3A%2F%2Flocalhost%3A9000", ExplorerURL("http://localhost:9000"))
+
+	config.Loaded = &config.Config{ExplorerURLTemplate: "https://explorer.example.com/?rpc={rpc}&theme=dark"}
+	assert.Equal(t, "https://explorer.example.com/?rpc=http%3A%2F%2Flocalhost%3A9100&theme=dark", ExplorerURL("http://localhost:9100"))
+}
+
 // ── parseDeployLog ─────────────────────────────────────────────────
 
 func TestParseDeployLog(t *testing.T) {
@@ -86,6 +105,21 @@ func TestParseEnvLog_Partial(t *testing.T) {
 	assert.Empty(t, env.playerAAddress)
 }
 
+func TestParseEnvLog_ExtraRoles(t *testing.T) {
+	input := `ADMIN_PRIVATE_KEY=suiprivkey0000fake0000
+PLAYER_C_ADDRESS=0xcc11
+PLAYER_C_PRIVATE_KEY=suiprivkey3333fake3333
+export GAS_BUDDY_PRIVATE_KEY=suiprivkey4444fake4444
+WORLD_PACKAGE_ADDRESS=0xdead
+`
+	env := parseEnvLog(bufio.NewScanner(strings.NewReader(input)))
+
+	assert.Equal(t, "suiprivkey0000fake0000", env.adminKey)
+	assert.Equal(t, []string{"ADMIN", "PLAYER_A", "PLAYER_B", "PLAYER_C", "GAS_BUDDY"}, env.roles())
+	assert.Equal(t, "0xcc11", env.addresses["PLAYER_C"])
+	assert.Equal(t, "suiprivkey4444fake4444", env.keys["GAS_BUDDY"])
+}
+
 // ── patchEntrypointPostgresWait ────────────────────────────────────
 
 func TestPatchEntrypointPostgresWait_InjectsWaitBlock(t *testing.T) {
@@ -144,18 +178,28 @@ func TestPatchEntrypointLoopTimings_Idempotent(t *testing.T) {
 
 func TestCleanEnvironment_CallsCleanup(t *testing.T) {
 	mock := new(mockContainerClient)
-	mock.On("Cleanup").Return(nil)
+	mock.On("Cleanup", container.CleanupOptions{}).Return(nil)
 
-	err := CleanEnvironment(mock)
+	err := CleanEnvironment(mock, container.CleanupOptions{})
+	require.NoError(t, err)
+	mock.AssertExpectations(t)
+}
+
+func TestCleanEnvironment_PassesKeepFrontendModules(t *testing.T) {
+	mock := new(mockContainerClient)
+	opts := container.CleanupOptions{KeepFrontendModules: true}
+	mock.On("Cleanup", opts).Return(nil)
+
+	err := CleanEnvironment(mock, opts)
 	require.NoError(t, err)
 	mock.AssertExpectations(t)
 }
 
 func TestCleanEnvironment_PropagatesError(t *testing.T) {
 	mock := new(mockContainerClient)
-	mock.On("Cleanup").Return(assert.AnError)
+	mock.On("Cleanup", container.CleanupOptions{}).Return(assert.AnError)
 
-	err := CleanEnvironment(mock)
+	err := CleanEnvironment(mock, container.CleanupOptions{})
 	assert.Error(t, err)
 }
 
@@ -166,10 +210,10 @@ func TestCloneRepositories_Success(t *testing.T) {
 	ws := t.TempDir()
 
 	g.On("SetupWorkDir", ws).Return(nil)
-	g.On("CloneRepository", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
-	g.On("CheckoutRef", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
+	g.On("CloneRepository", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
+	g.On("CheckoutRef", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
 
-	err := CloneRepositories(g, ws)
+	err := CloneRepositories(context.Background(), g, ws, CloneOptions{})
 	require.NoError(t, err)
 	g.AssertExpectations(t)
 	// Should have cloned two repos (world-contracts + builder-scaffold)
@@ -181,7 +225,7 @@ func TestCloneRepositories_SetupFails(t *testing.T) {
 	g := new(mockGitClient)
 	g.On("SetupWorkDir", mock.Anything).Return(assert.AnError)
 
-	err := CloneRepositories(g, "/tmp/fail")
+	err := CloneRepositories(context.Background(), g, "/tmp/fail", CloneOptions{})
 	assert.Error(t, err)
 }
 
@@ -189,9 +233,9 @@ func TestCloneRepositories_CloneFails(t *testing.T) {
 	g := new(mockGitClient)
 	ws := t.TempDir()
 	g.On("SetupWorkDir", ws).Return(nil)
-	g.On("CloneRepository", mock.Anything, mock.Anything).Return(assert.AnError)
+	g.On("CloneRepository", mock.Anything, mock.Anything, mock.Anything).Return(assert.AnError)
 
-	err := CloneRepositories(g, ws)
+	err := CloneRepositories(context.Background(), g, ws, CloneOptions{})
 	assert.Error(t, err)
 }
 
@@ -202,16 +246,128 @@ func TestCloneRepositories_UsesWorkspaceSubdirs(t *testing.T) {
 	builderPath := filepath.Join(ws, "builder-scaffold")
 
 	g.On("SetupWorkDir", ws).Return(nil)
-	g.On("CloneRepository", mock.AnythingOfType("string"), worldPath).Return(nil)
-	g.On("CheckoutRef", worldPath, mock.AnythingOfType("string")).Return(nil)
-	g.On("CloneRepository", mock.AnythingOfType("string"), builderPath).Return(nil)
-	g.On("CheckoutRef", builderPath, mock.AnythingOfType("string")).Return(nil)
+	g.On("CloneRepository", mock.Anything, mock.AnythingOfType("string"), worldPath).Return(nil)
+	g.On("CheckoutRef", mock.Anything, worldPath, mock.AnythingOfType("string")).Return(nil)
+	g.On("CloneRepository", mock.Anything, mock.AnythingOfType("string"), builderPath).Return(nil)
+	g.On("CheckoutRef", mock.Anything, builderPath, mock.AnythingOfType("string")).Return(nil)
 
-	err := CloneRepositories(g, ws)
+	err := CloneRepositories(context.Background(), g, ws, CloneOptions{})
 	require.NoError(t, err)
 	g.AssertExpectations(t)
 }
 
+func TestRequireRepositories(t *testing.T) {
+	ws := t.TempDir()
+
+	err := RequireRepositories(ws)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "world-contracts, builder-scaffold")
+
+	require.NoError(t, os.Mkdir(filepath.Join(ws, "world-contracts"), 0o750))
+	err = RequireRepositories(ws)
+	require.Error(t, err)
+	assert.NotContains(t, err.Error(), "world-contracts")
+
+	require.NoError(t, os.Mkdir(filepath.Join(ws, "builder-scaffold"), 0o750))
+	assert.NoError(t, RequireRepositories(ws))
+}
+
+func TestCloneRepositories_BranchFallback(t *testing.T) {
+	missing := fmt.Errorf("%w: %q in repo", git.ErrRefNotFound, "nope")
+	newClient := func() *mockGitClient {
+		g := new(mockGitClient)
+		g.On("SetupWorkDir", mock.Anything).Return(nil)
+		g.On("CloneRepository", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		g.On("CheckoutRef", mock.Anything, mock.Anything, mock.Anything).Return(missing)
+		return g
+	}
+
+	err := CloneRepositories(context.Background(), newClient(), t.TempDir(), CloneOptions{})
+	require.ErrorIs(t, err, git.ErrRefNotFound)
+
+	g := newClient()
+	require.NoError(t, CloneRepositories(context.Background(), g, t.TempDir(), CloneOptions{BranchFallback: true}))
+	g.AssertNumberOfCalls(t, "CloneRepository", 2)
+}
+
+func TestCloneRepositories_KeepGoing(t *testing.T) {
+	ws := t.TempDir()
+	worldPath := filepath.Join(ws, "world-contracts")
+	builderPath := filepath.Join(ws, "builder-scaffold")
+	newClient := func() *mockGitClient {
+		g := new(mockGitClient)
+		g.On("SetupWorkDir", ws).Return(nil)
+		g.On("CloneRepository", mock.Anything, mock.Anything, worldPath).Return(assert.AnError)
+		g.On("CloneRepository", mock.Anything, mock.Anything, builderPath).Return(nil)
+		g.On("CheckoutRef", mock.Anything, builderPath, mock.Anything).Return(nil)
+		return g
+	}
+
+	g := newClient()
+	require.Error(t, CloneRepositories(context.Background(), g, ws, CloneOptions{}))
+	g.AssertNumberOfCalls(t, "CloneRepository", 1)
+
+	g = newClient()
+	err := CloneRepositories(context.Background(), g, ws, CloneOptions{KeepGoing: true})
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Contains(t, err.Error(), "world-contracts")
+	g.AssertNumberOfCalls(t, "CloneRepository", 2)
+	g.AssertCalled(t, "CheckoutRef", mock.Anything, builderPath, mock.Anything)
+}
+
+func TestCloneRepositories_KeepGoingStopsWhenCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	ws := t.TempDir()
+	g := new(mockGitClient)
+	g.On("SetupWorkDir", ws).Return(nil)
+	g.On("CloneRepository", mock.Anything, mock.Anything, mock.Anything).Return(context.Canceled)
+
+	err := CloneRepositories(ctx, g, ws, CloneOptions{KeepGoing: true})
+	require.ErrorIs(t, err, context.Canceled)
+	g.AssertNumberOfCalls(t, "CloneRepository", 1)
+}
+
+func TestCloneRepositories_PinnedCommit(t *testing.T) {
+	oldLoaded := config.Loaded
+	config.Loaded = &config.Config{WorldContractsCommit: "abc1234"}
+	defer func() { config.Loaded = oldLoaded }()
+
+	g := new(mockGitClient)
+	ws := t.TempDir()
+	worldPath := filepath.Join(ws, "world-contracts")
+
+	g.On("SetupWorkDir", ws).Return(nil)
+	g.On("CloneRepository", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
+	g.On("CheckoutRef", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
+	g.On("HeadCommit", worldPath).Return("ABC1234def5678abc1234def5678abc1234def56", nil)
+
+	err := CloneRepositories(context.Background(), g, ws, CloneOptions{})
+	require.NoError(t, err)
+	g.AssertCalled(t, "CheckoutRef", mock.Anything, worldPath, "abc1234")
+	g.AssertNumberOfCalls(t, "CheckoutRef", 3)
+	g.AssertNumberOfCalls(t, "HeadCommit", 1)
+}
+
+func TestCloneRepositories_PinnedCommitMismatch(t *testing.T) {
+	oldLoaded := config.Loaded
+	config.Loaded = &config.Config{BuilderScaffoldCommit: "abc1234"}
+	defer func() { config.Loaded = oldLoaded }()
+
+	g := new(mockGitClient)
+	ws := t.TempDir()
+
+	g.On("SetupWorkDir", ws).Return(nil)
+	g.On("CloneRepository", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
+	g.On("CheckoutRef", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
+	g.On("HeadCommit", filepath.Join(ws, "builder-scaffold")).Return("fff0000", nil)
+
+	err := CloneRepositories(context.Background(), g, ws, CloneOptions{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "expected pinned commit abc1234")
+}
+
 func TestResolveRepoPath_RejectsUnsafeRepoName(t *testing.T) {
 	ws := t.TempDir()
 
@@ -264,6 +420,64 @@ echo '{"activeAddress":"0xabc","addresses":[["ef-admin","0xabc"]]}'
 	assert.Contains(t, string(calls), "client addresses --json")
 }
 
+func TestAddressResolver_QueriesSuiOncePerSummary(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	configDir := filepath.Join(home, ".sui", "sui_config")
+	require.NoError(t, os.MkdirAll(configDir, 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(configDir, "client.yaml"), []byte("config"), 0600))
+
+	binDir := t.TempDir()
+	counter := filepath.Join(home, "sui_calls")
+	script := `#!/bin/sh
+printf '%s\n' "$*" >> ` + counter + `
+case "$1" in
+--version) echo 'sui 1.66.1-abc123' ;;
+*) echo '{"activeAddress":"0xabc","addresses":[["ef-admin","0xabc"],["ef-player-a","0xdef"]]}' ;;
+esac
+`
+	require.NoError(t, os.WriteFile(filepath.Join(binDir, "sui"), []byte(script), 0755))
+	t.Setenv("PATH", binDir+string(filepath.ListSeparator)+os.Getenv("PATH"))
+
+	resolver := new(addressResolver)
+	assert.Equal(t, "0xabc", resolver.resolve("ef-admin"))
+	assert.Equal(t, "0xdef", resolver.resolve("ef-player-a"))
+
+	calls, err := os.ReadFile(counter)
+	require.NoError(t, err)
+	assert.Equal(t, 1, strings.Count(string(calls), "client addresses --json"))
+	assert.Equal(t, 1, strings.Count(string(calls), "--version"))
+}
+
+func TestDeriveRoleAddress_DerivesWithoutSui(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("PATH", t.TempDir())
+
+	key := "suiprivkey1qzgv6g33hpr66xkvu94lff8l3smw9ggq8w54rvkse7cdxy0yjjsh7dxgser" // gitleaks:allow
+	info := deriveRoleAddress("Admin", "ef-admin", "", key, func(string) string {
+		t.Fatal("the keystore must not be consulted when the key derives")
+		return ""
+	})
+
+	assert.Equal(t, "0x1cde4f2de0639971fbb9261591f4bbe8d100b695dddae5408e79df84ad2ba05a", info.Address)
+}
+
+func TestAddressesParser_PrefersVersionAndFallsBack(t *testing.T) {
+	pairs := []byte(`{"activeAddress":"0xabc","addresses":[["ef-admin","0xabc"]]}`)
+	legacy := []byte(`{"0xdef":"ef-admin"}`)
+
+	assert.Equal(t, "0xabc", addressesParser("1.66.1-abc123")(pairs, "ef-admin"))
+	assert.Equal(t, "0xdef", addressesParser("1.66.1-abc123")(legacy, "ef-admin"), "falls back to the older format")
+
+	assert.Equal(t, "0xdef", addressesParser("1.60.0")(legacy, "ef-admin"))
+	assert.Equal(t, "0xabc", addressesParser("1.60.0")(pairs, "ef-admin"), "falls back to the newer format")
+	assert.Empty(t, addressesParser("1.60.0")(pairs, "ef-unknown"))
+
+	assert.Equal(t, "0xabc", addressesParser("")(pairs, "ef-admin"))
+	assert.Equal(t, "0xdef", addressesParser("")(legacy, "ef-admin"))
+}
+
 func TestResolveRepoPath_RejectsSymlinkEscape(t *testing.T) {
 	ws := t.TempDir()
 	external := t.TempDir()
@@ -274,3 +488,46 @@ func TestResolveRepoPath_RejectsSymlinkEscape(t *testing.T) {
 	_, err := resolveRepoPath(ws, "builder-scaffold")
 	require.Error(t, err)
 }
+
+func TestResolveWorkspacePath_RelativeMatchesAbsolute(t *testing.T) {
+	parent, err := filepath.EvalSymlinks(t.TempDir())
+	require.NoError(t, err)
+	ws := filepath.Join(parent, "ws")
+	require.NoError(t, os.Mkdir(ws, 0750))
+	t.Chdir(parent)
+
+	rel, err := ResolveWorkspacePath("./ws")
+	require.NoError(t, err)
+	abs, err := ResolveWorkspacePath(ws)
+	require.NoError(t, err)
+	assert.Equal(t, abs, rel)
+	assert.Equal(t, ws, rel)
+}
+
+func TestResolveWorkspacePath_ResolvesSymlink(t *testing.T) {
+	target, err := filepath.EvalSymlinks(t.TempDir())
+	require.NoError(t, err)
+	link := filepath.Join(t.TempDir(), "link")
+	require.NoError(t, os.Symlink(target, link))
+
+	got, err := ResolveWorkspacePath(link)
+	require.NoError(t, err)
+	assert.Equal(t, target, got)
+}
+
+func TestCloneRepositories_RelativeWorkspace(t *testing.T) {
+	parent, err := filepath.EvalSymlinks(t.TempDir())
+	require.NoError(t, err)
+	ws := filepath.Join(parent, "ws")
+	require.NoError(t, os.Mkdir(ws, 0750))
+	t.Chdir(parent)
+
+	g := new(mockGitClient)
+	g.On("SetupWorkDir", ws).Return(nil)
+	g.On("CloneRepository", mock.Anything, mock.AnythingOfType("string"), filepath.Join(ws, "world-contracts")).Return(nil)
+	g.On("CloneRepository", mock.Anything, mock.AnythingOfType("string"), filepath.Join(ws, "builder-scaffold")).Return(nil)
+	g.On("CheckoutRef", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
+
+	require.NoError(t, CloneRepositories(context.Background(), g, "./ws", CloneOptions{}))
+	g.AssertExpectations(t)
+}
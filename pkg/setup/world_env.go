@@ -0,0 +1,74 @@
+package setup
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"efctl/pkg/container"
+)
+
+// The world-contracts/.env file is created by a root script inside the sui-playground
+// container, so on Linux hosts it is owned by root and the host user may not
+// be able to read or write it. These helpers go through the container instead.
+
+// ReadWorldEnv reads the KEY=VALUE pairs of world-contracts/.env through the
+// sui-playground container.
+func ReadWorldEnv(ctx context.Context, c container.ContainerClient) (map[string]string, error) {
+	content, err := readWorldEnvContent(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	return parseDotEnv(strings.NewReader(content))
+}
+
+// UpdateWorldEnv sets the given keys in world-contracts/.env through the
+// sui-playground container, as UpdateEnvFile does for a host file.
+func UpdateWorldEnv(ctx context.Context, c container.ContainerClient, updates map[string]string) error {
+	content, err := readWorldEnvContent(ctx, c)
+	if err != nil {
+		return err
+	}
+	return writeWorldEnvContent(ctx, c, updateEnvContent(content, updates))
+}
+
+// AppendWorldEnv appends lines to world-contracts/.env through the
+// sui-playground container, starting on a new line if the file does not end
+// with one.
+func AppendWorldEnv(ctx context.Context, c container.ContainerClient, lines []string) error {
+	content, err := readWorldEnvContent(ctx, c)
+	if err != nil {
+		return err
+	}
+	var sb strings.Builder
+	sb.WriteString(content)
+	if content != "" && !strings.HasSuffix(content, "\n") {
+		sb.WriteByte('\n')
+	}
+	for _, line := range lines {
+		sb.WriteString(line)
+		sb.WriteByte('\n')
+	}
+	return writeWorldEnvContent(ctx, c, sb.String())
+}
+
+func readWorldEnvContent(ctx context.Context, c container.ContainerClient) (string, error) {
+	content, err := c.ExecCapture(ctx, container.ContainerSuiPlayground, []string{"cat", containerEnvPath})
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s in %s: %w", containerEnvPath, container.ContainerSuiPlayground, err)
+	}
+	return content, nil
+}
+
+// writeWorldEnvContent replaces the content of world-contracts/.env in place,
+// keeping its owner and mode. The content is passed base64-encoded so values
+// never need shell quoting.
+func writeWorldEnvContent(ctx context.Context, c container.ContainerClient, content string) error {
+	encoded := base64.StdEncoding.EncodeToString([]byte(content))
+	cmd := fmt.Sprintf("printf '%%s' '%s' | base64 -d > '%s'", encoded, containerEnvPath)
+	if err := c.Exec(ctx, container.ContainerSuiPlayground, []string{"/bin/bash", "-c", cmd}); err != nil {
+		return fmt.Errorf("failed to write %s in %s: %w", containerEnvPath, container.ContainerSuiPlayground, err)
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package setup
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"regexp"
+	"testing"
+
+	"efctl/pkg/container"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var writtenEnvRegex = regexp.MustCompile(`^printf '%s' '([A-Za-z0-9+/=]*)' \| base64 -d > '` + regexp.QuoteMeta(containerEnvPath) + `'$`)
+
+// expectWorldEnv mocks world-contracts/.env in the sui-playground container
+// holding content, and returns a pointer to what gets written back.
+func expectWorldEnv(mc *mockContainerClient, content string) *string {
+	written := new(string)
+	mc.On("ExecCapture", mock.Anything, container.ContainerSuiPlayground, []string{"cat", containerEnvPath}).Return(content, nil)
+	mc.On("Exec", mock.Anything, container.ContainerSuiPlayground, mock.MatchedBy(func(cmd []string) bool {
+		return len(cmd) == 3 && cmd[0] == "/bin/bash" && writtenEnvRegex.MatchString(cmd[2])
+	})).Run(func(args mock.Arguments) {
+		m := writtenEnvRegex.FindStringSubmatch(args.Get(2).([]string)[2])
+		data, _ := base64.StdEncoding.DecodeString(m[1])
+		*written = string(data)
+	}).Return(nil)
+	return written
+}
+
+func TestUpdateWorldEnv_WritesThroughContainer(t *testing.T) {
+	mc := new(mockContainerClient)
+	written := expectWorldEnv(mc, "# world\nFOO=old\nBAR=keep\n")
+
+	err := UpdateWorldEnv(context.Background(), mc, map[string]string{"FOO": "it's $new", "NEW": "1"})
+	require.NoError(t, err)
+	assert.Equal(t, "# world\nFOO=it's $new\nBAR=keep\n\nNEW=1", *written)
+	mc.AssertExpectations(t)
+}
+
+func TestAppendWorldEnv_StartsOnNewLine(t *testing.T) {
+	mc := new(mockContainerClient)
+	written := expectWorldEnv(mc, "FOO=bar")
+
+	require.NoError(t, AppendWorldEnv(context.Background(), mc, []string{"A=1", "B=2"}))
+	assert.Equal(t, "FOO=bar\nA=1\nB=2\n", *written)
+}
+
+func TestUpdateWorldEnv_ReadFailureWritesNothing(t *testing.T) {
+	mc := new(mockContainerClient)
+	mc.On("ExecCapture", mock.Anything, container.ContainerSuiPlayground, []string{"cat", containerEnvPath}).
+		Return("", errors.New("no such file"))
+
+	err := UpdateWorldEnv(context.Background(), mc, map[string]string{"FOO": "bar"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no such file")
+	mc.AssertNotCalled(t, "Exec", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestReadWorldEnv_ParsesContainerFile(t *testing.T) {
+	mc := new(mockContainerClient)
+	expectWorldEnv(mc, "# c\nADMIN_ADDRESS=0x1\n")
+
+	env, err := ReadWorldEnv(context.Background(), mc)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"ADMIN_ADDRESS": "0x1"}, env)
+}
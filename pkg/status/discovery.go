@@ -1,6 +1,7 @@
 package status
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -13,7 +14,7 @@ type DiscoveredPackage struct {
 	Owner   string
 }
 
-func DiscoverAssemblies(endpoint, worldPkgID string) ([]DiscoveredObject, error) {
+func DiscoverAssemblies(ctx context.Context, endpoint, worldPkgID string) ([]DiscoveredObject, error) {
 	if worldPkgID == "" {
 		return nil, nil
 	}
@@ -26,7 +27,7 @@ func DiscoverAssemblies(endpoint, worldPkgID string) ([]DiscoveredObject, error)
 
 	var all []DiscoveredObject
 	for _, t := range types {
-		objs, err := queryObjectsByType(endpoint, t)
+		objs, err := queryObjectsByType(ctx, endpoint, t)
 		if err != nil {
 			return nil, err
 		}
@@ -36,14 +37,17 @@ func DiscoverAssemblies(endpoint, worldPkgID string) ([]DiscoveredObject, error)
 	return all, nil
 }
 
-func DiscoverExtensions(endpoint string, packageIDs []string) ([]DiscoveredObject, error) {
+func DiscoverExtensions(ctx context.Context, endpoint string, packageIDs []string) ([]DiscoveredObject, error) {
 	if len(packageIDs) == 0 {
 		return nil, nil
 	}
 
 	var all []DiscoveredObject
 	for _, pkgID := range packageIDs {
-		modules, err := queryPackageModules(endpoint, pkgID)
+		modules, err := queryPackageModules(ctx, endpoint, pkgID)
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
 		if err != nil {
 			// Fallback to searching common modules if package lookup fails
 			modules = []string{"extension", "config"}
@@ -51,7 +55,7 @@ func DiscoverExtensions(endpoint string, packageIDs []string) ([]DiscoveredObjec
 
 		for _, mod := range modules {
 			extType := fmt.Sprintf("%s::%s::ExtensionConfig", pkgID, mod)
-			objs, err := queryObjectsByType(endpoint, extType)
+			objs, err := queryObjectsByType(ctx, endpoint, extType)
 			if err != nil {
 				return nil, err
 			}
@@ -61,7 +65,7 @@ func DiscoverExtensions(endpoint string, packageIDs []string) ([]DiscoveredObjec
 	return all, nil
 }
 
-func DiscoverPackages(endpoint string, owners []string) ([]DiscoveredPackage, error) {
+func DiscoverPackages(ctx context.Context, endpoint string, owners []string) ([]DiscoveredPackage, error) {
 	if len(owners) == 0 {
 		return nil, nil
 	}
@@ -86,8 +90,11 @@ func DiscoverPackages(endpoint string, owners []string) ([]DiscoveredPackage, er
 			"owner": owner,
 			"type":  capType,
 		}
-		resp, err := graphql.RunQuery(endpoint, query, variables)
+		resp, err := graphql.RunQueryContext(ctx, endpoint, query, variables)
 		if err != nil {
+			if ctx.Err() != nil {
+				return allPkgs, ctx.Err()
+			}
 			continue // Skip this owner if query fails
 		}
 
@@ -137,7 +144,7 @@ func DiscoverPackages(endpoint string, owners []string) ([]DiscoveredPackage, er
 	return allPkgs, nil
 }
 
-func queryObjectsByType(endpoint, objectType string) ([]DiscoveredObject, error) {
+func queryObjectsByType(ctx context.Context, endpoint, objectType string) ([]DiscoveredObject, error) {
 	query := `query ($type: String!) {
 		objects(filter: { type: $type }) {
 			nodes {
@@ -155,7 +162,7 @@ func queryObjectsByType(endpoint, objectType string) ([]DiscoveredObject, error)
 	}`
 
 	variables := map[string]interface{}{"type": objectType}
-	resp, err := graphql.RunQuery(endpoint, query, variables)
+	resp, err := graphql.RunQueryContext(ctx, endpoint, query, variables)
 	if err != nil {
 		return nil, err
 	}
@@ -163,7 +170,7 @@ func queryObjectsByType(endpoint, objectType string) ([]DiscoveredObject, error)
 	return parseObjectNodes(resp.Data, objectType), nil
 }
 
-func queryPackageModules(endpoint, packageID string) ([]string, error) {
+func queryPackageModules(ctx context.Context, endpoint, packageID string) ([]string, error) {
 	query := `query ($address: SuiAddress!) {
 		object(address: $address) {
 			asMovePackage {
@@ -177,7 +184,7 @@ func queryPackageModules(endpoint, packageID string) ([]string, error) {
 	}`
 
 	variables := map[string]interface{}{"address": packageID}
-	resp, err := graphql.RunQuery(endpoint, query, variables)
+	resp, err := graphql.RunQueryContext(ctx, endpoint, query, variables)
 	if err != nil {
 		return nil, err
 	}
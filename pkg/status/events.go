@@ -0,0 +1,69 @@
+package status
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// WorldEvent is one event emitted by the world package, as reported by
+// suix_queryEvents. The JSON form is one line of `env events` output.
+type WorldEvent struct {
+	TxDigest    string                 `json:"txDigest"`
+	EventSeq    string                 `json:"eventSeq"`
+	PackageID   string                 `json:"packageId"`
+	Module      string                 `json:"transactionModule"`
+	Sender      string                 `json:"sender"`
+	Type        string                 `json:"type"`
+	TimestampMs string                 `json:"timestampMs,omitempty"`
+	ParsedJSON  map[string]interface{} `json:"parsedJson,omitempty"`
+}
+
+// ID identifies the event uniquely: a transaction can emit several events,
+// told apart by their sequence number.
+func (e WorldEvent) ID() string {
+	return e.TxDigest + ":" + e.EventSeq
+}
+
+// Time returns when the event's checkpoint was created, or the zero time when
+// the node did not report it.
+func (e WorldEvent) Time() time.Time {
+	return parseTimestampMs(e.TimestampMs)
+}
+
+// WorldEventFilter returns the world package ID and admin address of the
+// workspace, the inputs QueryWorldEvents needs. Either is empty when unknown.
+func WorldEventFilter(workspace string) (pkgID, admin string) {
+	ids, _ := ReadObjectIDs(workspace, "localnet")
+	return ids.PackageID, extractAddresses(extractEnvVars(workspace))["Admin"]
+}
+
+// QueryWorldEvents returns up to limit of the most recent events sent by
+// sender, newest first, keeping only those emitted by the world package pkgID.
+func QueryWorldEvents(ctx context.Context, client *http.Client, rpcURL, pkgID, sender string, limit int) ([]WorldEvent, error) {
+	payload := fmt.Sprintf(`{"jsonrpc":"2.0","id":1,"method":"suix_queryEvents","params":[{"Sender":%q},null,%d,true]}`, sender, limit)
+	var res struct {
+		Data []struct {
+			ID struct {
+				TxDigest string `json:"txDigest"`
+				EventSeq string `json:"eventSeq"`
+			} `json:"id"`
+			WorldEvent
+		} `json:"data"`
+	}
+	if err := rpcCall(ctx, client, rpcURL, payload, &res); err != nil {
+		return nil, err
+	}
+
+	var events []WorldEvent
+	for _, d := range res.Data {
+		if d.PackageID != pkgID {
+			continue
+		}
+		ev := d.WorldEvent
+		ev.TxDigest, ev.EventSeq = d.ID.TxDigest, d.ID.EventSeq
+		events = append(events, ev)
+	}
+	return events, nil
+}
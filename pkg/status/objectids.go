@@ -0,0 +1,123 @@
+package status
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// ObjectIDsFileName is the world-contracts deploy output that lists the core world objects.
+const ObjectIDsFileName = "extracted-object-ids.json"
+
+// DeployLogFileName is the log the world-contracts deploy writes next to the
+// object IDs file.
+const DeployLogFileName = "deploy.log"
+
+// SupportedObjectIDsVersion is the newest extracted-object-ids.json schema
+// version efctl understands. Files without a version field predate versioning
+// and are treated as compatible.
+const SupportedObjectIDsVersion = 1
+
+// coreWorldKeys are the world entries efctl relies on for summaries and the
+// dashboard. Their absence usually means the schema changed upstream.
+var coreWorldKeys = []string{"packageId", "governorCap", "adminAcl", "objectRegistry"}
+
+// ObjectIDs is the generic view of extracted-object-ids.json. Objects holds
+// every string entry of the "world" section except packageId, so new keys
+// added upstream are still shown.
+type ObjectIDs struct {
+	Version   int
+	Network   string
+	PackageID string
+	Objects   map[string]string
+	Warnings  []string
+}
+
+// ObjectIDsPath returns the extracted-object-ids.json path for network in the
+// workspace, falling back to the test-env layout used by integration tests.
+func ObjectIDsPath(workspace, network string) string {
+	path := filepath.Join(workspace, "world-contracts", "deployments", network, ObjectIDsFileName)
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		fallback := filepath.Join(workspace, "test-env", "world-contracts", "deployments", network, ObjectIDsFileName)
+		if _, err := os.Stat(fallback); err == nil {
+			return fallback
+		}
+	}
+	return path
+}
+
+// DeployLogPath returns the localnet deploy.log path in the workspace.
+func DeployLogPath(workspace string) string {
+	return filepath.Join(workspace, "world-contracts", "deployments", "localnet", DeployLogFileName)
+}
+
+// ReadObjectIDs reads and parses the object IDs file for network.
+func ReadObjectIDs(workspace, network string) (ObjectIDs, error) {
+	data, err := os.ReadFile(ObjectIDsPath(workspace, network)) // #nosec G304 -- path is workspace-relative by design
+	if err != nil {
+		return ObjectIDs{Objects: map[string]string{}}, err
+	}
+	return ParseObjectIDs(data, network)
+}
+
+// ParseObjectIDs extracts whatever world objects are present in data and
+// records warnings when the schema version, network, or core keys differ from
+// what efctl expects, so missing objects are not mistaken for a failed deploy.
+func ParseObjectIDs(data []byte, network string) (ObjectIDs, error) {
+	ids := ObjectIDs{Objects: map[string]string{}}
+
+	var top map[string]interface{}
+	if err := json.Unmarshal(data, &top); err != nil {
+		return ids, fmt.Errorf("failed to parse %s: %w", ObjectIDsFileName, err)
+	}
+
+	if v, ok := top["version"].(float64); ok {
+		ids.Version = int(v)
+		switch {
+		case ids.Version > SupportedObjectIDsVersion:
+			ids.Warnings = append(ids.Warnings, fmt.Sprintf("%s schema version %d is newer than efctl supports (%d); some objects may be missing. Try updating efctl.", ObjectIDsFileName, ids.Version, SupportedObjectIDsVersion))
+		case ids.Version < SupportedObjectIDsVersion:
+			ids.Warnings = append(ids.Warnings, fmt.Sprintf("%s schema version %d is older than efctl expects (%d); some objects may be missing. Try re-running env up.", ObjectIDsFileName, ids.Version, SupportedObjectIDsVersion))
+		}
+	}
+
+	if n, ok := top["network"].(string); ok {
+		ids.Network = n
+		if network != "" && n != network {
+			ids.Warnings = append(ids.Warnings, fmt.Sprintf("%s was generated for network %q, expected %q", ObjectIDsFileName, n, network))
+		}
+	}
+
+	world, ok := top["world"].(map[string]interface{})
+	if !ok {
+		ids.Warnings = append(ids.Warnings, fmt.Sprintf("%s has no \"world\" section; its schema may differ from what efctl expects", ObjectIDsFileName))
+		return ids, nil
+	}
+
+	for key, value := range world {
+		str, ok := value.(string)
+		if !ok {
+			continue
+		}
+		if key == "packageId" {
+			ids.PackageID = str
+			continue
+		}
+		ids.Objects[key] = str
+	}
+
+	var missing []string
+	for _, key := range coreWorldKeys {
+		if _, ok := world[key].(string); !ok {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		ids.Warnings = append(ids.Warnings, fmt.Sprintf("%s is missing expected world keys %v; its schema may differ from what efctl expects", ObjectIDsFileName, missing))
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,72 @@
+package status
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"efctl/pkg/httpclient"
+)
+
+// ObjectTypeCache remembers the Move type of each object ID it has looked up.
+// An object's type never changes, so each ID costs one sui_getObject call per
+// process and later refreshes are served from memory.
+type ObjectTypeCache struct {
+	mu    sync.Mutex
+	types map[string]string
+}
+
+// objectTypes is the cache GatherWorldInfo uses, shared across dashboard ticks.
+var objectTypes = &ObjectTypeCache{}
+
+// Types returns the shortened Move type for each entry of objects (key → object
+// ID), fetching uncached IDs from rpcURL. Objects the node does not know are
+// left out. The first failed request stops the lookups so an unreachable node
+// costs one timeout; the remaining IDs are retried on the next call.
+func (c *ObjectTypeCache) Types(ctx context.Context, rpcURL string, objects map[string]string) map[string]string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.types == nil {
+		c.types = make(map[string]string)
+	}
+
+	client := httpclient.NewEndpoint(RPCTimeout)
+	result := make(map[string]string, len(objects))
+	for key, id := range objects {
+		t, ok := c.types[id]
+		if !ok {
+			if rpcURL == "" {
+				continue
+			}
+			var err error
+			if t, err = fetchObjectType(ctx, client, rpcURL, id); err != nil {
+				rpcURL = ""
+				continue
+			}
+			c.types[id] = t
+		}
+		if t != "" {
+			result[key] = t
+		}
+	}
+	return result
+}
+
+// fetchObjectType asks the node for the Move type of id. An object the node
+// does not have yields an empty type and no error.
+func fetchObjectType(ctx context.Context, client *http.Client, rpcURL, id string) (string, error) {
+	payload := fmt.Sprintf(`{"jsonrpc":"2.0","id":1,"method":"sui_getObject","params":[%q,{"showType":true}]}`, id)
+	var res struct {
+		Data *struct {
+			Type string `json:"type"`
+		} `json:"data"`
+	}
+	if err := rpcCall(ctx, client, rpcURL, payload, &res); err != nil {
+		return "", err
+	}
+	if res.Data == nil {
+		return "", nil
+	}
+	return shortenType(res.Data.Type), nil
+}
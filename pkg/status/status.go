@@ -1,19 +1,25 @@
 package status
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
 	"efctl/pkg/container"
 	"efctl/pkg/env"
+	"efctl/pkg/httpclient"
+	"efctl/pkg/secrets"
 	"efctl/pkg/sui"
 )
 
@@ -47,10 +53,12 @@ type WorldInfo struct {
 	PackageID      string
 	DiscoveredPkgs []DiscoveredPackage
 	Objects        map[string]string
+	ObjectTypes    map[string]string // object key → shortened Move type, when the RPC answered
 	Addresses      map[string]string
 	Assemblies     []DiscoveredObject
 	Extensions     []DiscoveredObject
 	DiscoveryErr   string
+	SchemaWarnings []string // extracted-object-ids.json schema mismatches
 }
 
 type EnvironmentStatus struct {
@@ -60,21 +68,93 @@ type EnvironmentStatus struct {
 	World      WorldInfo
 }
 
-func Gather(engine, workspace, rpcURL string) EnvironmentStatus {
+// Gather collects container, port, chain, and world status. Cancelling ctx
+// aborts the in-flight engine commands and RPC requests.
+func Gather(ctx context.Context, engine, workspace, rpcURL string) EnvironmentStatus {
 	return EnvironmentStatus{
-		Containers: GatherContainerStats(engine),
-		Ports: []PortStat{
-			{Name: "Sui RPC", Port: 9000, InUse: !env.IsPortAvailable(9000)},
-			{Name: "GraphQL", Port: 9125, InUse: !env.IsPortAvailable(9125)},
-			{Name: "PostgreSQL", Port: 5432, InUse: !env.IsPortAvailable(5432)},
-			{Name: "Frontend", Port: 5173, InUse: !env.IsPortAvailable(5173)},
-		},
-		Chain: GatherChainHealth(rpcURL),
-		World: GatherWorldInfo(workspace, rpcURL),
+		Containers: GatherContainerStats(ctx, engine),
+		Ports:      GatherPorts(ctx, engine),
+		Chain:      GatherChainHealth(ctx, rpcURL),
+		World:      GatherWorldInfo(ctx, workspace, rpcURL),
 	}
 }
 
-func GatherContainerStats(engine string) []ContainerStat {
+// DefaultRPCURL is the Sui JSON-RPC endpoint env up publishes by default.
+const DefaultRPCURL = "http://localhost:9000"
+
+// servicePorts lists the container ports env up publishes, in display order.
+var servicePorts = []struct {
+	name      string
+	container string
+	port      int
+}{
+	{"Sui RPC", container.ContainerSuiPlayground, 9000},
+	{"GraphQL", container.ContainerSuiPlayground, 9125},
+	{"PostgreSQL", container.ContainerPostgres, 5432},
+	{"Frontend", container.ContainerFrontend, 5173},
+}
+
+// GatherPorts reports whether each service port is in use. Ports are read
+// from the running containers' published mappings when available, so a
+// remapped setup is reported as it actually runs; otherwise the defaults are
+// used.
+func GatherPorts(ctx context.Context, engine string) []PortStat {
+	ports := make([]PortStat, 0, len(servicePorts))
+	for _, sp := range servicePorts {
+		port := sp.port
+		if engine != "" {
+			if _, published, ok := publishedPort(ctx, engine, sp.container, sp.port); ok {
+				port = published
+			}
+		}
+		ports = append(ports, PortStat{Name: sp.name, Port: port, InUse: !env.IsPortAvailable(port)})
+	}
+	return ports
+}
+
+// DetectRPCURL returns the JSON-RPC URL published by the running Sui
+// container, falling back to DefaultRPCURL when it cannot be determined.
+func DetectRPCURL(ctx context.Context, engine string) string {
+	if engine == "" {
+		return DefaultRPCURL
+	}
+	host, port, ok := publishedPort(ctx, engine, container.ContainerSuiPlayground, 9000)
+	if !ok {
+		return DefaultRPCURL
+	}
+	return "http://" + net.JoinHostPort(host, strconv.Itoa(port))
+}
+
+func publishedPort(ctx context.Context, engine, name string, containerPort int) (string, int, bool) {
+	out, err := exec.CommandContext(ctx, engine, "port", name, fmt.Sprintf("%d/tcp", containerPort)).Output() // #nosec G204 -- engine is validated by env.CheckPrerequisites().Engine() to be "docker" or "podman"
+	if err != nil {
+		return "", 0, false
+	}
+	return parsePortOutput(string(out))
+}
+
+// parsePortOutput parses the first mapping printed by `<engine> port`, e.g.
+// "127.0.0.1:9000" or "0.0.0.0:9000". Wildcard hosts become localhost.
+func parsePortOutput(out string) (string, int, bool) {
+	for _, line := range strings.Split(out, "\n") {
+		host, portStr, err := net.SplitHostPort(strings.TrimSpace(line))
+		if err != nil {
+			continue
+		}
+		port, err := strconv.Atoi(portStr)
+		if err != nil || port <= 0 {
+			continue
+		}
+		switch host {
+		case "", "0.0.0.0", "::":
+			host = "localhost"
+		}
+		return host, port, true
+	}
+	return "", 0, false
+}
+
+func GatherContainerStats(ctx context.Context, engine string) []ContainerStat {
 	sui := ContainerStat{Name: container.ContainerSuiPlayground, Status: "Stopped", CPU: "-", Mem: "-"}
 	pg := ContainerStat{Name: container.ContainerPostgres, Status: "Stopped", CPU: "-", Mem: "-"}
 	fe := ContainerStat{Name: container.ContainerFrontend, Status: "Stopped", CPU: "-", Mem: "-"}
@@ -83,18 +163,18 @@ func GatherContainerStats(engine string) []ContainerStat {
 		return []ContainerStat{sui, pg, fe}
 	}
 
-	out, err := exec.Command(engine, "stats", "--no-stream", "--format", "{{.Name}}\t{{.CPUPerc}}\t{{.MemUsage}}").Output() // #nosec G204 -- engine is validated by env.CheckPrerequisites().Engine() to be "docker" or "podman"
+	out, err := exec.CommandContext(ctx, engine, "stats", "--no-stream", "--format", "{{.Name}}\t{{.CPUPerc}}\t{{.MemUsage}}").Output() // #nosec G204 -- engine is validated by env.CheckPrerequisites().Engine() to be "docker" or "podman"
 	if err == nil {
 		sui, pg, fe = parseStatsOutput(string(out), sui, pg, fe)
 	}
 
-	if sui.Status == "Stopped" && containerRunning(engine, container.ContainerSuiPlayground) {
+	if sui.Status == "Stopped" && containerRunning(ctx, engine, container.ContainerSuiPlayground) {
 		sui.Status = "Running"
 	}
-	if pg.Status == "Stopped" && containerRunning(engine, container.ContainerPostgres) {
+	if pg.Status == "Stopped" && containerRunning(ctx, engine, container.ContainerPostgres) {
 		pg.Status = "Running"
 	}
-	if fe.Status == "Stopped" && containerRunning(engine, container.ContainerFrontend) {
+	if fe.Status == "Stopped" && containerRunning(ctx, engine, container.ContainerFrontend) {
 		fe.Status = "Running"
 	}
 
@@ -129,33 +209,54 @@ func parseStatsOutput(out string, sui, pg, fe ContainerStat) (ContainerStat, Con
 	return sui, pg, fe
 }
 
-func containerRunning(engine, name string) bool {
-	out, err := exec.Command(engine, "inspect", "--format", "{{.State.Running}}", name).Output() // #nosec G204 -- engine is validated by env.CheckPrerequisites().Engine() to be "docker" or "podman"
+func containerRunning(ctx context.Context, engine, name string) bool {
+	out, err := exec.CommandContext(ctx, engine, "inspect", "--format", "{{.State.Running}}", name).Output() // #nosec G204 -- engine is validated by env.CheckPrerequisites().Engine() to be "docker" or "podman"
 	if err != nil {
 		return false
 	}
 	return strings.TrimSpace(string(out)) == "true"
 }
 
-func GatherChainHealth(rpcURL string) ChainStat {
+// DefaultRPCTimeout is the default per-call timeout for chain health RPC
+// requests.
+const DefaultRPCTimeout = 1 * time.Second
+
+// RPCTimeout is the per-call timeout GatherChainHealth uses. It is overridden
+// by the --rpc-poll-timeout flag.
+var RPCTimeout = DefaultRPCTimeout
+
+// GatherChainHealth reports the node's checkpoint, epoch, and transaction
+// count using a client with the RPCTimeout per-call timeout.
+func GatherChainHealth(ctx context.Context, rpcURL string) ChainStat {
+	return QueryChainHealth(ctx, httpclient.NewEndpoint(RPCTimeout), rpcURL)
+}
+
+// QueryChainHealth is GatherChainHealth with the caller's client. RPCStatus is
+// "Healthy", "Offline", or "Unresponsive" when the node accepted the
+// connection but timed out; the remaining calls are skipped in that case.
+func QueryChainHealth(ctx context.Context, client *http.Client, rpcURL string) ChainStat {
 	result := ChainStat{RPCStatus: "Offline", Checkpoint: "-", Epoch: "-", TxCount: "-"}
-	client := &http.Client{Timeout: 1 * time.Second}
 
 	var checkpoint string
-	if err := rpcCall(client, rpcURL, `{"jsonrpc":"2.0","id":1,"method":"sui_getLatestCheckpointSequenceNumber","params":[]}`, &checkpoint); err == nil {
+	if err := rpcCall(ctx, client, rpcURL, `{"jsonrpc":"2.0","id":1,"method":"sui_getLatestCheckpointSequenceNumber","params":[]}`, &checkpoint); err == nil {
 		result.Checkpoint = checkpoint
 		result.RPCStatus = "Healthy"
+	} else if IsTimeout(err) {
+		// The node accepted the connection but never answered; the remaining
+		// calls would each stall for the full timeout too.
+		result.RPCStatus = "Unresponsive"
+		return result
 	}
 
 	var txCount string
-	if err := rpcCall(client, rpcURL, `{"jsonrpc":"2.0","id":1,"method":"sui_getTotalTransactionBlocks","params":[]}`, &txCount); err == nil {
+	if err := rpcCall(ctx, client, rpcURL, `{"jsonrpc":"2.0","id":1,"method":"sui_getTotalTransactionBlocks","params":[]}`, &txCount); err == nil {
 		result.TxCount = txCount
 	}
 
 	var epochRes struct {
 		Epoch string `json:"epoch"`
 	}
-	if err := rpcCall(client, rpcURL, `{"jsonrpc":"2.0","id":1,"method":"sui_getLatestSuiSystemState","params":[]}`, &epochRes); err == nil {
+	if err := rpcCall(ctx, client, rpcURL, `{"jsonrpc":"2.0","id":1,"method":"sui_getLatestSuiSystemState","params":[]}`, &epochRes); err == nil {
 		if epochRes.Epoch != "" {
 			result.Epoch = epochRes.Epoch
 		}
@@ -164,8 +265,15 @@ func GatherChainHealth(rpcURL string) ChainStat {
 	return result
 }
 
-func rpcCall(client *http.Client, rpcURL, payload string, result interface{}) error {
-	req, err := http.NewRequest("POST", rpcURL, strings.NewReader(payload))
+// IsTimeout reports whether err is a network timeout, as opposed to a refused
+// connection or an error response.
+func IsTimeout(err error) bool {
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+func rpcCall(ctx context.Context, client *http.Client, rpcURL, payload string, result interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, "POST", rpcURL, strings.NewReader(payload))
 	if err != nil {
 		return err
 	}
@@ -183,30 +291,42 @@ func rpcCall(client *http.Client, rpcURL, payload string, result interface{}) er
 
 	var envelope struct {
 		Result json.RawMessage `json:"result"`
+		Error  *struct {
+			Message string `json:"message"`
+		} `json:"error"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&envelope); err != nil {
 		return err
 	}
+	if envelope.Error != nil {
+		return errors.New(envelope.Error.Message)
+	}
 	if len(envelope.Result) == 0 {
 		return fmt.Errorf("empty result")
 	}
 	return json.Unmarshal(envelope.Result, result)
 }
 
-func GatherWorldInfo(workspace, rpcURL string) WorldInfo {
+// GatherWorldInfo reads the deployed world from the workspace, looks up the
+// Move type of each world object over RPC, and discovers assemblies, packages,
+// and extensions over GraphQL. Object types are cached for the life of the
+// process. Cancelling ctx aborts the requests.
+func GatherWorldInfo(ctx context.Context, workspace, rpcURL string) WorldInfo {
 	envVars := extractEnvVars(workspace)
 	addresses := extractAddresses(envVars)
-	objs, pkgID := extractWorldObjects(workspace)
+	objectIDs, _ := ReadObjectIDs(workspace, "localnet")
 
 	// Try to find builder package ID in multiple locations
 	builderPkgID := extractBuilderPackageID(workspace)
 
 	info := WorldInfo{
-		PackageID:      pkgID,
+		PackageID:      objectIDs.PackageID,
 		DiscoveredPkgs: []DiscoveredPackage{}, // Will be populated below
-		Objects:        objs,
+		Objects:        objectIDs.Objects,
 		Addresses:      addresses,
+		SchemaWarnings: objectIDs.Warnings,
 	}
+	info.ObjectTypes = objectTypes.Types(ctx, rpcURL, objectIDs.Objects)
 
 	// Dynamic discovery via GraphQL if available
 	// Shift port from 9000 (RPC) to 9125 (GraphQL)
@@ -215,7 +335,7 @@ func GatherWorldInfo(workspace, rpcURL string) WorldInfo {
 		gqlURL = strings.TrimSuffix(gqlURL, "/") + "/graphql"
 	}
 
-	assemblies, errA := DiscoverAssemblies(gqlURL, pkgID)
+	assemblies, errA := DiscoverAssemblies(ctx, gqlURL, objectIDs.PackageID)
 	if errA != nil {
 		info.DiscoveryErr = fmt.Sprintf("Assemblies: %v", errA)
 	}
@@ -226,7 +346,8 @@ func GatherWorldInfo(workspace, rpcURL string) WorldInfo {
 	for _, addr := range addresses {
 		ownerAddresses = append(ownerAddresses, addr)
 	}
-	discoveredPkgs, errP := DiscoverPackages(gqlURL, ownerAddresses)
+	sort.Strings(ownerAddresses)
+	discoveredPkgs, errP := DiscoverPackages(ctx, gqlURL, ownerAddresses)
 	if errP != nil {
 		if info.DiscoveryErr != "" {
 			info.DiscoveryErr += "; "
@@ -254,7 +375,7 @@ func GatherWorldInfo(workspace, rpcURL string) WorldInfo {
 
 	info.DiscoveredPkgs = allPkgs
 
-	extensions, errE := DiscoverExtensions(gqlURL, allPkgIDs)
+	extensions, errE := DiscoverExtensions(ctx, gqlURL, allPkgIDs)
 	if errE != nil {
 		if info.DiscoveryErr != "" {
 			info.DiscoveryErr += "; "
@@ -288,6 +409,7 @@ func extractEnvVars(workspace string) map[string]string {
 			result[parts[0]] = parts[1]
 		}
 	}
+	secrets.Overlay(secrets.Default, envPath, result)
 	return result
 }
 
@@ -350,42 +472,6 @@ func extractAddresses(envVars map[string]string) map[string]string {
 	return addresses
 }
 
-func extractWorldObjects(workspace string) (map[string]string, string) {
-	objs := make(map[string]string)
-	filePath := filepath.Join(workspace, "world-contracts", "deployments", "localnet", "extracted-object-ids.json")
-	if _, err := os.Stat(filePath); os.IsNotExist(err) {
-		filePath = filepath.Join(workspace, "test-env", "world-contracts", "deployments", "localnet", "extracted-object-ids.json")
-	}
-
-	data, err := os.ReadFile(filePath) // #nosec G304 -- path is workspace-relative by design
-	if err != nil {
-		return objs, ""
-	}
-
-	var top map[string]interface{}
-	if err := json.Unmarshal(data, &top); err != nil {
-		return objs, ""
-	}
-
-	world, ok := top["world"].(map[string]interface{})
-	if !ok {
-		return objs, ""
-	}
-
-	pkgID := ""
-	for key, value := range world {
-		strValue, ok := value.(string)
-		if !ok {
-			continue
-		}
-		if key == "packageId" {
-			pkgID = strValue
-			continue
-		}
-		objs[key] = strValue
-	}
-	return objs, pkgID
-}
 func extractBuilderPackageID(workspace string) string {
 	// 1. Try builder-scaffold/.env
 	builderEnvPath := filepath.Join(workspace, "builder-scaffold", ".env")
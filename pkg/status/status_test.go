@@ -1,9 +1,18 @@
 package status
 
 import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
+
+	"efctl/pkg/container"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -28,6 +37,73 @@ func TestParseStatsOutput(t *testing.T) {
 	assert.Equal(t, "7.1%", fe.CPU)
 }
 
+func TestParsePortOutput(t *testing.T) {
+	tests := []struct {
+		out  string
+		host string
+		port int
+		ok   bool
+	}{
+		{"127.0.0.1:19000\n", "127.0.0.1", 19000, true},
+		{"0.0.0.0:9000\n[::]:9000\n", "localhost", 9000, true},
+		{"[::]:9125\n", "localhost", 9125, true},
+		{"", "", 0, false},
+		{"Error: no public port '9000/tcp' published\n", "", 0, false},
+	}
+	for _, tt := range tests {
+		host, port, ok := parsePortOutput(tt.out)
+		assert.Equal(t, tt.ok, ok, tt.out)
+		assert.Equal(t, tt.host, host, tt.out)
+		assert.Equal(t, tt.port, port, tt.out)
+	}
+}
+
+func TestDetectRPCURL_NoEngineUsesDefault(t *testing.T) {
+	assert.Equal(t, DefaultRPCURL, DetectRPCURL(context.Background(), ""))
+}
+
+func TestGatherChainHealth_StalledRPCIsUnresponsive(t *testing.T) {
+	release := make(chan struct{})
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		<-release
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	orig := RPCTimeout
+	RPCTimeout = 50 * time.Millisecond
+	defer func() { RPCTimeout = orig }()
+
+	chain := GatherChainHealth(context.Background(), srv.URL)
+
+	assert.Equal(t, "Unresponsive", chain.RPCStatus)
+	assert.Equal(t, int32(1), calls.Load(), "remaining calls should be skipped after a timeout")
+}
+
+func TestGatherChainHealth_CancelledContextReturnsPromptly(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	orig := RPCTimeout
+	RPCTimeout = time.Minute
+	defer func() { RPCTimeout = orig }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	chain := GatherChainHealth(ctx, srv.URL)
+
+	assert.Less(t, time.Since(start), 5*time.Second)
+	assert.NotEqual(t, "Healthy", chain.RPCStatus)
+}
+
 func TestGatherWorldInfo(t *testing.T) {
 	workspace := t.TempDir()
 
@@ -41,7 +117,7 @@ func TestGatherWorldInfo(t *testing.T) {
 	jsonContent := `{"world":{"packageId":"0x111","governorCap":"0x222","adminAcl":"0x333"}}`
 	require.NoError(t, os.WriteFile(filepath.Join(deployDir, "extracted-object-ids.json"), []byte(jsonContent), 0600))
 
-	info := GatherWorldInfo(workspace, "http://localhost:9000")
+	info := GatherWorldInfo(context.Background(), workspace, "http://localhost:9000")
 
 	assert.Equal(t, "0x111", info.PackageID)
 	assert.Equal(t, "0x222", info.Objects["governorCap"])
@@ -52,6 +128,72 @@ func TestGatherWorldInfo(t *testing.T) {
 	assert.False(t, hasNonAddress)
 }
 
+func TestGatherWorldInfo_CancelledDiscovery(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}))
+	defer srv.Close()
+	defer close(release) // unblock stalled handlers before Close waits for them
+
+	workspace := t.TempDir()
+	deployDir := filepath.Join(workspace, "world-contracts", "deployments", "localnet")
+	require.NoError(t, os.MkdirAll(deployDir, 0750))
+	require.NoError(t, os.WriteFile(filepath.Join(workspace, "world-contracts", ".env"), []byte("ADMIN_ADDRESS=0xabc\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(deployDir, "extracted-object-ids.json"), []byte(`{"world":{"packageId":"0x111"}}`), 0600))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	info := GatherWorldInfo(ctx, workspace, srv.URL)
+
+	assert.Less(t, time.Since(start), 2*time.Second, "discovery should stop when ctx is done")
+	assert.Equal(t, "0x111", info.PackageID)
+	assert.NotEmpty(t, info.DiscoveryErr)
+}
+
+func TestObjectTypeCache_FetchesOnce(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		body, _ := io.ReadAll(r.Body)
+		if strings.Contains(string(body), "0x222") {
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":{"data":{"objectId":"0x222","type":"0x1234567890abcdef::access::GovernorCap"}}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":{"error":{"code":"notExists","object_id":"0x333"}}}`))
+	}))
+	defer srv.Close()
+
+	cache := &ObjectTypeCache{}
+	objects := map[string]string{"governorCap": "0x222", "adminAcl": "0x333"}
+
+	types := cache.Types(context.Background(), srv.URL, objects)
+	assert.Equal(t, map[string]string{"governorCap": "0x1234...cdef::access::GovernorCap"}, types)
+	assert.Equal(t, int32(2), calls.Load())
+
+	types = cache.Types(context.Background(), srv.URL, objects)
+	assert.Equal(t, "0x1234...cdef::access::GovernorCap", types["governorCap"])
+	assert.Equal(t, int32(2), calls.Load(), "known objects must be served from the cache")
+}
+
+func TestObjectTypeCache_StopsOnUnreachableNode(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	cache := &ObjectTypeCache{}
+	types := cache.Types(context.Background(), srv.URL, map[string]string{"a": "0x1", "b": "0x2", "c": "0x3"})
+	assert.Empty(t, types)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
 func TestExtractEnvVarsFallback(t *testing.T) {
 	workspace := t.TempDir()
 
@@ -65,3 +207,140 @@ func TestExtractEnvVarsFallback(t *testing.T) {
 	vars := extractEnvVars(workspace)
 	assert.Equal(t, "0x999", vars["ADMIN_ADDRESS"])
 }
+
+func TestParseObjectIDs_CurrentSchema(t *testing.T) {
+	data := `{"network":"localnet","world":{"packageId":"0x1","governorCap":"0x2","adminAcl":"0x3","objectRegistry":"0x4","newThing":"0x5","nested":{"a":"b"}}}`
+
+	ids, err := ParseObjectIDs([]byte(data), "localnet")
+	require.NoError(t, err)
+	assert.Equal(t, "0x1", ids.PackageID)
+	assert.Equal(t, map[string]string{"governorCap": "0x2", "adminAcl": "0x3", "objectRegistry": "0x4", "newThing": "0x5"}, ids.Objects)
+	assert.Empty(t, ids.Warnings)
+}
+
+func TestParseObjectIDs_SchemaMismatchWarnings(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want string
+	}{
+		{"newer version", `{"version":99,"world":{"packageId":"0x1","governorCap":"0x2","adminAcl":"0x3","objectRegistry":"0x4"}}`, "newer than efctl supports"},
+		{"older version", `{"version":0,"world":{"packageId":"0x1","governorCap":"0x2","adminAcl":"0x3","objectRegistry":"0x4"}}`, "older than efctl expects"},
+		{"network mismatch", `{"network":"testnet","world":{"packageId":"0x1","governorCap":"0x2","adminAcl":"0x3","objectRegistry":"0x4"}}`, `expected "localnet"`},
+		{"missing world", `{"objects":{"packageId":"0x1"}}`, `no "world" section`},
+		{"renamed keys", `{"world":{"package_id":"0x1","governor_cap":"0x2"}}`, "missing expected world keys"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ids, err := ParseObjectIDs([]byte(tt.data), "localnet")
+			require.NoError(t, err)
+			require.NotEmpty(t, ids.Warnings)
+			assert.Contains(t, strings.Join(ids.Warnings, "\n"), tt.want)
+		})
+	}
+}
+
+func TestParseObjectIDs_RenamedKeysStillExtracted(t *testing.T) {
+	ids, err := ParseObjectIDs([]byte(`{"world":{"package_id":"0x1","governor_cap":"0x2"}}`), "localnet")
+	require.NoError(t, err)
+	assert.Equal(t, "0x1", ids.Objects["package_id"])
+	assert.Equal(t, "0x2", ids.Objects["governor_cap"])
+}
+
+func TestParseObjectIDs_InvalidJSON(t *testing.T) {
+	_, err := ParseObjectIDs([]byte("{"), "localnet")
+	assert.Error(t, err)
+}
+
+func TestDeployLogPath(t *testing.T) {
+	assert.Equal(t, filepath.Join("ws", "world-contracts", "deployments", "localnet", "deploy.log"), DeployLogPath("ws"))
+}
+
+func TestHealthSummary(t *testing.T) {
+	st := EnvironmentStatus{
+		Containers: []ContainerStat{
+			{Name: container.ContainerSuiPlayground, Status: "Running"},
+			{Name: container.ContainerPostgres, Status: "Running"},
+			{Name: container.ContainerFrontend, Status: "Stopped"},
+		},
+		Chain: ChainStat{RPCStatus: "Healthy", Checkpoint: "1234"},
+		World: WorldInfo{PackageID: "0xabc"},
+	}
+
+	line, healthy := HealthSummary(st, []string{container.ContainerSuiPlayground, container.ContainerPostgres})
+	assert.True(t, healthy)
+	assert.Equal(t, "RPC: Healthy (checkpoint 1234) | Containers: 2/2 up | World package: 0xabc | Try: efctl env dash", line)
+
+	st.Chain = ChainStat{RPCStatus: "Unresponsive", Checkpoint: "-"}
+	st.World = WorldInfo{}
+	line, healthy = HealthSummary(st, []string{container.ContainerSuiPlayground, container.ContainerFrontend})
+	assert.False(t, healthy)
+	assert.Equal(t, "RPC: Unresponsive | Containers: 1/2 up (down: "+container.ContainerFrontend+") | World package: not found | Try: efctl env status, efctl doctor", line)
+}
+
+func TestQueryWorldEvents_FiltersWorldPackage(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.Contains(t, string(body), `"suix_queryEvents"`)
+		assert.Contains(t, string(body), `{"Sender":"0xadmin"},null,5,true`)
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":{"data":[
+			{"id":{"txDigest":"D2","eventSeq":"0"},"packageId":"0xworld","transactionModule":"gate","sender":"0xadmin","type":"0xworld::gate::JumpEvent","timestampMs":"1700000000000","parsedJson":{"gate":"0x1"}},
+			{"id":{"txDigest":"D1","eventSeq":"1"},"packageId":"0xother","transactionModule":"coin","sender":"0xadmin","type":"0xother::coin::Minted"}
+		]}}`))
+	}))
+	defer srv.Close()
+
+	events, err := QueryWorldEvents(context.Background(), srv.Client(), srv.URL, "0xworld", "0xadmin", 5)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, "D2:0", events[0].ID())
+	assert.Equal(t, "gate", events[0].Module)
+	assert.Equal(t, "0x1", events[0].ParsedJSON["gate"])
+	assert.Equal(t, time.UnixMilli(1700000000000), events[0].Time())
+}
+
+func TestQueryWorldEvents_Unreachable(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer srv.Close()
+
+	_, err := QueryWorldEvents(context.Background(), srv.Client(), srv.URL, "0xworld", "0xadmin", 5)
+	assert.Error(t, err)
+}
+
+func TestQueryRecentTransactions(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.Contains(t, string(body), `"suix_queryTransactionBlocks"`)
+		assert.Contains(t, string(body), `null,3,true`)
+		_, _ = w.Write([]byte(`{"result":{"data":[
+			{"digest":"D1","timestampMs":"1700000000000",
+			 "transaction":{"data":{"sender":"0xabc","transaction":{"kind":"ProgrammableTransaction"}}},
+			 "effects":{"status":{"status":"success"},"gasUsed":{"computationCost":"1000","storageCost":"2000","storageRebate":"500"}}},
+			{"digest":"D0"}
+		]}}`))
+	}))
+	defer srv.Close()
+
+	txs, err := QueryRecentTransactions(context.Background(), srv.Client(), srv.URL, 3)
+	require.NoError(t, err)
+	require.Len(t, txs, 2)
+	assert.Equal(t, Transaction{
+		Digest: "D1", Sender: "0xabc", Kind: "ProgrammableTransaction", Status: "success", TimestampMs: "1700000000000",
+		Gas: GasUsed{ComputationCost: "1000", StorageCost: "2000", StorageRebate: "500"},
+	}, txs[0])
+	assert.True(t, txs[1].Time().IsZero())
+}
+
+func TestQueryTransaction_ReportsRPCError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"error":{"code":-32602,"message":"Could not find the referenced transaction"}}`))
+	}))
+	defer srv.Close()
+
+	_, err := QueryTransaction(context.Background(), srv.Client(), srv.URL, "MISSING")
+	require.Error(t, err)
+	assert.Equal(t, "Could not find the referenced transaction", err.Error())
+}
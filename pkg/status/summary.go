@@ -0,0 +1,48 @@
+package status
+
+import (
+	"fmt"
+	"strings"
+)
+
+// HealthSummary condenses s into a single line such as
+// "RPC: Healthy (checkpoint 1234) | Containers: 2/2 up | World package: 0x… | Try: efctl env dash".
+// expected lists the containers that should be running. healthy is false
+// when the RPC is not answering or an expected container is down.
+func HealthSummary(s EnvironmentStatus, expected []string) (line string, healthy bool) {
+	rpc := "RPC: " + s.Chain.RPCStatus
+	if s.Chain.RPCStatus == "Healthy" && s.Chain.Checkpoint != "-" {
+		rpc += fmt.Sprintf(" (checkpoint %s)", s.Chain.Checkpoint)
+	}
+
+	running := make(map[string]bool, len(s.Containers))
+	for _, c := range s.Containers {
+		running[c.Name] = c.Status == "Running"
+	}
+	var up int
+	var down []string
+	for _, name := range expected {
+		if running[name] {
+			up++
+		} else {
+			down = append(down, name)
+		}
+	}
+	containers := fmt.Sprintf("Containers: %d/%d up", up, len(expected))
+	if len(down) > 0 {
+		containers += fmt.Sprintf(" (down: %s)", strings.Join(down, ", "))
+	}
+
+	world := "World package: not found"
+	if s.World.PackageID != "" {
+		world = "World package: " + s.World.PackageID
+	}
+
+	healthy = s.Chain.RPCStatus == "Healthy" && len(down) == 0
+	next := "Try: efctl env dash"
+	if !healthy {
+		next = "Try: efctl env status, efctl doctor"
+	}
+
+	return strings.Join([]string{rpc, containers, world, next}, " | "), healthy
+}
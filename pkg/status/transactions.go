@@ -0,0 +1,143 @@
+package status
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// GasUsed is the gas summary of a transaction's effects, in MIST.
+type GasUsed struct {
+	ComputationCost string `json:"computationCost"`
+	StorageCost     string `json:"storageCost"`
+	StorageRebate   string `json:"storageRebate"`
+}
+
+// Transaction is one entry of suix_queryTransactionBlocks.
+type Transaction struct {
+	Digest      string
+	Sender      string
+	Kind        string // e.g. "ProgrammableTransaction"; empty when not reported
+	Status      string // "success" or "failure"; empty when not reported
+	TimestampMs string
+	Gas         GasUsed
+}
+
+// Time returns when the transaction's checkpoint was created, or the zero
+// time when the node did not report it.
+func (t Transaction) Time() time.Time {
+	return parseTimestampMs(t.TimestampMs)
+}
+
+// TransactionDetail is the result of sui_getTransactionBlock for one digest.
+type TransactionDetail struct {
+	Digest     string
+	Sender     string
+	Checkpoint string
+	Status     string
+	Error      string // abort message when Status is "failure"
+	Gas        GasUsed
+	Created    int
+	Mutated    int
+	Deleted    int
+	EventTypes []string // fully-qualified Move types of the emitted events
+}
+
+// transactionEffects is the effects block shared by both transaction queries.
+type transactionEffects struct {
+	Status struct {
+		Status string `json:"status"`
+		Error  string `json:"error"`
+	} `json:"status"`
+	GasUsed GasUsed           `json:"gasUsed"`
+	Created []json.RawMessage `json:"created"`
+	Mutated []json.RawMessage `json:"mutated"`
+	Deleted []json.RawMessage `json:"deleted"`
+}
+
+// QueryRecentTransactions returns up to limit of the most recent transactions,
+// newest first.
+func QueryRecentTransactions(ctx context.Context, client *http.Client, rpcURL string, limit int) ([]Transaction, error) {
+	payload := fmt.Sprintf(`{"jsonrpc":"2.0","id":1,"method":"suix_queryTransactionBlocks","params":[{"options":{"showInput":true,"showEffects":true}},null,%d,true]}`, limit)
+	var res struct {
+		Data []struct {
+			Digest      string `json:"digest"`
+			TimestampMs string `json:"timestampMs"`
+			Transaction struct {
+				Data struct {
+					Sender      string `json:"sender"`
+					Transaction struct {
+						Kind string `json:"kind"`
+					} `json:"transaction"`
+				} `json:"data"`
+			} `json:"transaction"`
+			Effects transactionEffects `json:"effects"`
+		} `json:"data"`
+	}
+	if err := rpcCall(ctx, client, rpcURL, payload, &res); err != nil {
+		return nil, err
+	}
+
+	txs := make([]Transaction, 0, len(res.Data))
+	for _, tx := range res.Data {
+		txs = append(txs, Transaction{
+			Digest:      tx.Digest,
+			Sender:      tx.Transaction.Data.Sender,
+			Kind:        tx.Transaction.Data.Transaction.Kind,
+			Status:      tx.Effects.Status.Status,
+			TimestampMs: tx.TimestampMs,
+			Gas:         tx.Effects.GasUsed,
+		})
+	}
+	return txs, nil
+}
+
+// QueryTransaction loads the effects and events of the transaction digest.
+func QueryTransaction(ctx context.Context, client *http.Client, rpcURL, digest string) (TransactionDetail, error) {
+	payload := fmt.Sprintf(`{"jsonrpc":"2.0","id":1,"method":"sui_getTransactionBlock","params":[%q,{"showInput":true,"showEffects":true,"showEvents":true}]}`, digest)
+	var res struct {
+		Digest      string `json:"digest"`
+		Checkpoint  string `json:"checkpoint"`
+		Transaction struct {
+			Data struct {
+				Sender string `json:"sender"`
+			} `json:"data"`
+		} `json:"transaction"`
+		Effects transactionEffects `json:"effects"`
+		Events  []struct {
+			Type string `json:"type"`
+		} `json:"events"`
+	}
+	if err := rpcCall(ctx, client, rpcURL, payload, &res); err != nil {
+		return TransactionDetail{}, err
+	}
+
+	detail := TransactionDetail{
+		Digest:     res.Digest,
+		Sender:     res.Transaction.Data.Sender,
+		Checkpoint: res.Checkpoint,
+		Status:     res.Effects.Status.Status,
+		Error:      res.Effects.Status.Error,
+		Gas:        res.Effects.GasUsed,
+		Created:    len(res.Effects.Created),
+		Mutated:    len(res.Effects.Mutated),
+		Deleted:    len(res.Effects.Deleted),
+	}
+	for _, ev := range res.Events {
+		detail.EventTypes = append(detail.EventTypes, ev.Type)
+	}
+	return detail, nil
+}
+
+// parseTimestampMs converts a millisecond timestamp string from the RPC into a
+// time, returning the zero time when it is missing or malformed.
+func parseTimestampMs(ms string) time.Time {
+	v, err := strconv.ParseInt(ms, 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+	return time.UnixMilli(v)
+}
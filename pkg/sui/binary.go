@@ -0,0 +1,68 @@
+package sui
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"efctl/pkg/ui"
+)
+
+// BinaryEnvVar names the environment variable that overrides the sui binary path.
+const BinaryEnvVar = "EFCTL_SUI_BIN"
+
+// binaryOverride is set from the --sui-binary flag and takes precedence over BinaryEnvVar.
+var binaryOverride string
+
+// SetBinary overrides the sui binary used by this package. An empty path
+// restores the EFCTL_SUI_BIN / PATH lookup.
+func SetBinary(path string) {
+	binaryOverride = strings.TrimSpace(path)
+}
+
+// Binary returns the sui executable to invoke: the --sui-binary override, then
+// EFCTL_SUI_BIN, then "sui" resolved from PATH.
+func Binary() string {
+	if binaryOverride != "" {
+		return binaryOverride
+	}
+	if env := strings.TrimSpace(os.Getenv(BinaryEnvVar)); env != "" {
+		return env
+	}
+	return "sui"
+}
+
+// suiCommand builds an exec.Cmd for the configured sui binary.
+func suiCommand(args ...string) *exec.Cmd {
+	cmd := exec.Command(Binary(), args...) // #nosec G204 -- binary is the user's own --sui-binary/EFCTL_SUI_BIN choice or "sui"
+	ui.Command(cmd.Args[0], cmd.Args[1:]...)
+	return cmd
+}
+
+// LookupDiagnostics explains where efctl looked for sui and how to install it.
+// It is intended to be printed when IsSuiInstalled returns false.
+func LookupDiagnostics() []string {
+	var lines []string
+	bin := Binary()
+	switch {
+	case binaryOverride != "":
+		lines = append(lines, fmt.Sprintf("--sui-binary is set to %q but it is not an executable file.", bin))
+	case os.Getenv(BinaryEnvVar) != "":
+		lines = append(lines, fmt.Sprintf("%s is set to %q but it is not an executable file.", BinaryEnvVar, bin))
+	default:
+		dirs := filepath.SplitList(os.Getenv("PATH"))
+		lines = append(lines, fmt.Sprintf("sui was not found in any PATH directory (%d searched): %s", len(dirs), strings.Join(dirs, string(filepath.ListSeparator))))
+	}
+
+	if home, err := os.UserHomeDir(); err == nil {
+		suiupBin := filepath.Join(home, ".local", "bin", "sui")
+		if _, err := os.Stat(suiupBin); err == nil && bin != suiupBin {
+			lines = append(lines, fmt.Sprintf("Found sui at %s; add its directory to PATH or pass --sui-binary %s.", suiupBin, suiupBin))
+		}
+	}
+
+	lines = append(lines, "Install sui with `efctl sui install` (uses suiup), or point efctl at an existing binary with --sui-binary or "+BinaryEnvVar+".")
+	return lines
+}
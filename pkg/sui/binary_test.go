@@ -0,0 +1,88 @@
+package sui
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBinary_DefaultsToSui(t *testing.T) {
+	SetBinary("")
+	t.Setenv(BinaryEnvVar, "")
+
+	assert.Equal(t, "sui", Binary())
+}
+
+func TestBinary_EnvOverride(t *testing.T) {
+	SetBinary("")
+	t.Setenv(BinaryEnvVar, "/opt/sui/bin/sui")
+
+	assert.Equal(t, "/opt/sui/bin/sui", Binary())
+}
+
+func TestBinary_FlagTakesPrecedence(t *testing.T) {
+	t.Setenv(BinaryEnvVar, "/opt/sui/bin/sui")
+	SetBinary("/custom/sui")
+	t.Cleanup(func() { SetBinary("") })
+
+	assert.Equal(t, "/custom/sui", Binary())
+}
+
+func TestIsSuiInstalled_UsesOverride(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("shell script stub requires a POSIX shell")
+	}
+	binDir := t.TempDir()
+	bin := filepath.Join(binDir, "sui-custom")
+	require.NoError(t, os.WriteFile(bin, []byte("#!/bin/sh\necho 'sui 1.66.1'\n"), 0755))
+	t.Setenv("PATH", t.TempDir())
+	SetBinary(bin)
+	t.Cleanup(func() { SetBinary("") })
+
+	assert.True(t, IsSuiInstalled())
+	version, err := Version()
+	require.NoError(t, err)
+	assert.Equal(t, "1.66.1", version)
+}
+
+func TestLookupDiagnostics_ListsPathAndInstallHint(t *testing.T) {
+	SetBinary("")
+	t.Setenv(BinaryEnvVar, "")
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("PATH", "/nowhere/bin")
+
+	lines := LookupDiagnostics()
+	joined := strings.Join(lines, "\n")
+
+	assert.Contains(t, joined, "/nowhere/bin")
+	assert.Contains(t, joined, "efctl sui install")
+}
+
+func TestLookupDiagnostics_ReportsBadOverride(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	SetBinary("/missing/sui")
+	t.Cleanup(func() { SetBinary("") })
+
+	lines := LookupDiagnostics()
+	assert.Contains(t, lines[0], "--sui-binary")
+	assert.Contains(t, lines[0], "/missing/sui")
+}
+
+func TestLookupDiagnostics_SuggestsSuiupLocation(t *testing.T) {
+	SetBinary("")
+	t.Setenv(BinaryEnvVar, "")
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("PATH", t.TempDir())
+	binDir := filepath.Join(home, ".local", "bin")
+	require.NoError(t, os.MkdirAll(binDir, 0750))
+	require.NoError(t, os.WriteFile(filepath.Join(binDir, "sui"), []byte(""), 0755))
+
+	joined := strings.Join(LookupDiagnostics(), "\n")
+	assert.Contains(t, joined, filepath.Join(binDir, "sui"))
+}
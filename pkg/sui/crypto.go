@@ -2,6 +2,7 @@ package sui
 
 import (
 	"crypto/ed25519"
+	"crypto/rand"
 	"encoding/hex"
 	"fmt"
 	"strings"
@@ -9,29 +10,92 @@ import (
 	"efctl/internal/blake2b256"
 )
 
+// Signature scheme flags Sui prefixes to private keys and public keys.
+const (
+	ed25519Flag   = 0x00
+	secp256k1Flag = 0x01
+	secp256r1Flag = 0x02
+)
+
+// privKeyHRP is the bech32 human-readable part of Sui private keys.
+const privKeyHRP = "suiprivkey"
+
+// DecodePrivKey decodes a bech32 Sui private key (suiprivkey1...) into its
+// signature scheme flag and 32-byte secret. It verifies the checksum, the
+// human-readable part, the payload length, and that the flag is a scheme Sui
+// supports.
+func DecodePrivKey(s string) (scheme byte, seed []byte, err error) {
+	hrp, data, err := bech32Decode(s)
+	if err != nil {
+		return 0, nil, fmt.Errorf("bech32 decode: %w", err)
+	}
+	if hrp != privKeyHRP {
+		return 0, nil, fmt.Errorf("unexpected HRP %q, expected %q", hrp, privKeyHRP)
+	}
+	if len(data) != 33 {
+		return 0, nil, fmt.Errorf("unexpected payload length %d, expected 33", len(data))
+	}
+	switch data[0] {
+	case ed25519Flag, secp256k1Flag, secp256r1Flag:
+	default:
+		return 0, nil, fmt.Errorf("unknown key scheme flag 0x%02x", data[0])
+	}
+	return data[0], data[1:], nil
+}
+
+// EncodePrivKey is the inverse of DecodePrivKey: it bech32-encodes scheme and
+// the 32-byte secret as a suiprivkey1... string.
+func EncodePrivKey(scheme byte, seed []byte) (string, error) {
+	if len(seed) != 32 {
+		return "", fmt.Errorf("unexpected secret length %d, expected 32", len(seed))
+	}
+	payload := make([]int, 0, 33)
+	payload = append(payload, int(scheme))
+	for _, b := range seed {
+		payload = append(payload, int(b))
+	}
+	data, err := bech32ConvertBits(payload, 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+	return bech32Encode(privKeyHRP, data), nil
+}
+
+// GenerateEd25519Key creates a random Ed25519 key and returns it as a
+// suiprivkey1... string together with its Sui address.
+func GenerateEd25519Key() (privkey, address string, err error) {
+	seed := make([]byte, ed25519.SeedSize)
+	if _, err := rand.Read(seed); err != nil {
+		return "", "", fmt.Errorf("failed to generate key: %w", err)
+	}
+	privkey, err = EncodePrivKey(ed25519Flag, seed)
+	if err != nil {
+		return "", "", err
+	}
+	address, err = DeriveAddressFromPrivateKey(privkey)
+	if err != nil {
+		return "", "", err
+	}
+	return privkey, address, nil
+}
+
 // DeriveAddressFromPrivateKey derives a Sui address from a bech32-encoded
 // private key (suiprivkey1...) without shelling out to the sui CLI.
 //
 // Algorithm:
-//  1. Bech32-decode the key → 33 bytes (1 flag byte + 32-byte Ed25519 seed)
+//  1. Decode the key → flag byte + 32-byte Ed25519 seed (see DecodePrivKey)
 //  2. Derive the Ed25519 public key from the seed
 //  3. Hash (flag_byte || public_key) with BLAKE2b-256
 //  4. Return the result as "0x" + hex
 func DeriveAddressFromPrivateKey(privkey string) (string, error) {
-	hrp, data, err := bech32Decode(privkey)
+	flag, seed, err := DecodePrivKey(privkey)
 	if err != nil {
-		return "", fmt.Errorf("bech32 decode: %w", err)
+		return "", err
 	}
-	if hrp != "suiprivkey" {
-		return "", fmt.Errorf("unexpected HRP %q, expected \"suiprivkey\"", hrp)
-	}
-	if len(data) != 33 {
-		return "", fmt.Errorf("unexpected payload length %d, expected 33", len(data))
+	if flag != ed25519Flag {
+		return "", fmt.Errorf("unsupported key scheme flag 0x%02x, only Ed25519 keys can be derived", flag)
 	}
 
-	flag := data[0] // 0x00 = Ed25519
-	seed := data[1:]
-
 	pub := ed25519.NewKeyFromSeed(seed).Public().(ed25519.PublicKey)
 
 	// Sui address = BLAKE2b-256( flag || public_key )
@@ -81,6 +145,26 @@ func bech32Decode(bech string) (string, []byte, error) {
 	return hrp, conv, nil
 }
 
+// bech32Encode joins hrp and 5-bit data with a checksum.
+func bech32Encode(hrp string, data []byte) string {
+	values := make([]int, len(data))
+	for i, b := range data {
+		values[i] = int(b)
+	}
+	polymod := bech32Polymod(append(append(bech32HRPExpand(hrp), values...), 0, 0, 0, 0, 0, 0)) ^ 1
+
+	var sb strings.Builder
+	sb.WriteString(hrp)
+	sb.WriteByte('1')
+	for _, v := range values {
+		sb.WriteByte(bech32Charset[v])
+	}
+	for i := 0; i < 6; i++ {
+		sb.WriteByte(bech32Charset[(polymod>>uint(5*(5-i)))&31])
+	}
+	return sb.String()
+}
+
 func bech32Polymod(values []int) int {
 	gen := [5]int{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
 	chk := 1
@@ -1,6 +1,7 @@
 package sui
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -30,6 +31,65 @@ func TestDeriveAddressFromPrivateKey_EmptyString(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestDecodePrivKey(t *testing.T) {
+	scheme, seed, err := DecodePrivKey("suiprivkey1qyrswpc8qurswpc8qurswpc8qurswpc8qurswpc8qurswpc8qurswua9amz") // gitleaks:allow
+	require.NoError(t, err)
+	assert.Equal(t, byte(0x01), scheme)
+	assert.Equal(t, bytes.Repeat([]byte{7}, 32), seed)
+
+	scheme, seed, err = DecodePrivKey("SUIPRIVKEY1QZGV6G33HPR66XKVU94LFF8L3SMW9GGQ8W54RVKSE7CDXY0YJJSH7DXGSER") // gitleaks:allow
+	require.NoError(t, err, "bech32 is case-insensitive")
+	assert.Equal(t, byte(0x00), scheme)
+	assert.Len(t, seed, 32)
+}
+
+func TestDecodePrivKey_Malformed(t *testing.T) {
+	tests := map[string]string{
+		"bad checksum": "suiprivkey1qyrswpc8qurswpc8qurswpc8qurswpc8qurswpc8qurswpc8qurswua9amq",
+		"wrong hrp":    "suipubkey1qqrswpc8qurswpc8qurswpc8qurswpc8qurswpc8qurswpc8qurswuwzwtl",
+		"short":        "suiprivkey1qqrswpc8qurswpc8qurswpc8qurswpc8qu9razqp",
+		"unknown flag": "suiprivkey1q5rswpc8qurswpc8qurswpc8qurswpc8qurswpc8qurswpc8qursw06r6hv",
+		"empty":        "",
+	}
+	for name, key := range tests {
+		_, _, err := DecodePrivKey(key)
+		assert.Error(t, err, name)
+	}
+}
+
+func TestEncodePrivKey_RoundTrip(t *testing.T) {
+	key := "suiprivkey1qzgv6g33hpr66xkvu94lff8l3smw9ggq8w54rvkse7cdxy0yjjsh7dxgser" // gitleaks:allow
+	scheme, seed, err := DecodePrivKey(key)
+	require.NoError(t, err)
+
+	encoded, err := EncodePrivKey(scheme, seed)
+	require.NoError(t, err)
+	assert.Equal(t, key, encoded)
+}
+
+func TestGenerateEd25519Key(t *testing.T) {
+	key, addr, err := GenerateEd25519Key()
+	require.NoError(t, err)
+
+	scheme, _, err := DecodePrivKey(key)
+	require.NoError(t, err)
+	assert.Equal(t, byte(0x00), scheme)
+	derived, err := DeriveAddressFromPrivateKey(key)
+	require.NoError(t, err)
+	assert.Equal(t, derived, addr)
+
+	other, _, err := GenerateEd25519Key()
+	require.NoError(t, err)
+	assert.NotEqual(t, key, other)
+}
+
+func TestDeriveAddressFromPrivateKey_RejectsNonEd25519(t *testing.T) {
+	// Flag 0x01 (Secp256k1) with a dummy seed.
+	_, err := DeriveAddressFromPrivateKey("suiprivkey1qyrswpc8qurswpc8qurswpc8qurswpc8qurswpc8qurswpc8qurswua9amz") // gitleaks:allow
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported key scheme flag 0x01")
+}
+
 func TestDeriveAddressFromPrivateKey_Deterministic(t *testing.T) {
 	key := "suiprivkey1qzgv6g33hpr66xkvu94lff8l3smw9ggq8w54rvkse7cdxy0yjjsh7dxgser" // gitleaks:allow
 	addr1, err1 := DeriveAddressFromPrivateKey(key)
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+
+	"efctl/pkg/httpclient"
 )
 
 // FaucetRequest represents the body of a faucet request
@@ -24,7 +26,7 @@ func RequestFaucet(faucetURL, address string) error {
 		return fmt.Errorf("failed to marshal faucet request: %w", err)
 	}
 
-	resp, err := http.Post(faucetURL+"/gas", "application/json", bytes.NewBuffer(jsonData))
+	resp, err := httpclient.New(0).Post(faucetURL+"/gas", "application/json", bytes.NewBuffer(jsonData))
 	if err != nil {
 		return fmt.Errorf("failed to send faucet request: %w", err)
 	}
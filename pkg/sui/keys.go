@@ -0,0 +1,142 @@
+package sui
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"efctl/pkg/ui"
+)
+
+// KeyImportSummary lists, by alias, what ConfigureSui did with each workspace key.
+type KeyImportSummary struct {
+	Imported []string // alias did not exist and the key was imported
+	Updated  []string // alias existed with a different key, or the key existed under another alias
+	Skipped  []string // alias already holds the same key
+	Failed   []string // the sui CLI rejected the change
+}
+
+// String renders a one-line summary such as "1 imported, 0 updated, 2 skipped, 0 failed".
+func (s KeyImportSummary) String() string {
+	return fmt.Sprintf("%d imported, %d updated, %d skipped, %d failed",
+		len(s.Imported), len(s.Updated), len(s.Skipped), len(s.Failed))
+}
+
+// keystoreEntry is one element of `sui keytool list --json`.
+type keystoreEntry struct {
+	Alias   string `json:"alias"`
+	Address string `json:"suiAddress"`
+}
+
+type keyAction int
+
+const (
+	keyActionImport  keyAction = iota // import under the alias
+	keyActionSkip                     // alias already holds this key
+	keyActionReplace                  // remove the stale alias, then import
+	keyActionRename                   // key exists under another alias; rename it
+)
+
+func parseKeytoolList(out []byte) ([]keystoreEntry, error) {
+	var entries []keystoreEntry
+	if err := json.Unmarshal(out, &entries); err != nil {
+		return nil, fmt.Errorf("failed to parse sui keytool list output: %w", err)
+	}
+	return entries, nil
+}
+
+func listKeystore() ([]keystoreEntry, error) {
+	out, err := suiCommand("keytool", "list", "--json").Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sui keystore: %w", err)
+	}
+	return parseKeytoolList(out)
+}
+
+// planKeyImport decides how to reconcile cfg with the existing keystore.
+// address is the address derived from cfg.Key, or empty if it is unknown.
+// For keyActionRename the alias currently holding the key is returned.
+func planKeyImport(cfg keyConfig, address string, existing []keystoreEntry) (keyAction, string) {
+	var aliasEntry, addrEntry *keystoreEntry
+	for i := range existing {
+		e := &existing[i]
+		if e.Alias == cfg.Alias {
+			aliasEntry = e
+		}
+		if address != "" && strings.EqualFold(e.Address, address) {
+			addrEntry = e
+		}
+	}
+
+	switch {
+	case aliasEntry != nil && address != "" && strings.EqualFold(aliasEntry.Address, address):
+		return keyActionSkip, ""
+	case aliasEntry != nil:
+		return keyActionReplace, ""
+	case addrEntry != nil:
+		return keyActionRename, addrEntry.Alias
+	default:
+		return keyActionImport, ""
+	}
+}
+
+// importKeys reconciles the sui keystore with configs so that every alias
+// holds exactly the key from the workspace .env.
+func importKeys(configs []keyConfig) KeyImportSummary {
+	var summary KeyImportSummary
+
+	existing, err := listKeystore()
+	if err != nil {
+		ui.Debug.Println("Could not inspect existing sui keys, importing unconditionally: " + err.Error())
+	}
+
+	for _, cfg := range configs {
+		address, err := DeriveAddressFromPrivateKey(cfg.Key)
+		if err != nil {
+			ui.Debug.Println(fmt.Sprintf("Could not derive address for %s: %v", cfg.Role, err))
+			address = ""
+		}
+
+		action, oldAlias := planKeyImport(cfg, address, existing)
+		switch action {
+		case keyActionSkip:
+			ui.Debug.Println(fmt.Sprintf("Key for %s already imported as alias: %s", cfg.Role, cfg.Alias))
+			summary.Skipped = append(summary.Skipped, cfg.Alias)
+		case keyActionRename:
+			ui.Info.Println(fmt.Sprintf("Renaming existing key for %s from %s to %s", cfg.Role, oldAlias, cfg.Alias))
+			if err := suiCommand("keytool", "update-alias", oldAlias, cfg.Alias).Run(); err != nil {
+				ui.Warn.Println(fmt.Sprintf("Failed to rename key alias for %s: %v", cfg.Role, err))
+				summary.Failed = append(summary.Failed, cfg.Alias)
+				continue
+			}
+			summary.Updated = append(summary.Updated, cfg.Alias)
+		case keyActionReplace:
+			ui.Info.Println(fmt.Sprintf("Replacing stale key for %s under alias: %s", cfg.Role, cfg.Alias))
+			_ = suiCommand("client", "remove-address", cfg.Alias).Run()
+			if err := runKeyImport(cfg); err != nil {
+				ui.Warn.Println(fmt.Sprintf("Failed to import key for %s: %v", cfg.Role, err))
+				summary.Failed = append(summary.Failed, cfg.Alias)
+				continue
+			}
+			summary.Updated = append(summary.Updated, cfg.Alias)
+		default:
+			ui.Info.Println(fmt.Sprintf("Importing key for %s as alias: %s", cfg.Role, cfg.Alias))
+			if err := runKeyImport(cfg); err != nil {
+				ui.Warn.Println(fmt.Sprintf("Failed to import key for %s: %v", cfg.Role, err))
+				summary.Failed = append(summary.Failed, cfg.Alias)
+				continue
+			}
+			summary.Imported = append(summary.Imported, cfg.Alias)
+		}
+	}
+
+	return summary
+}
+
+// runKeyImport imports cfg.Key via stdin to avoid exposing it in process
+// arguments (ps aux / /proc/pid/cmdline).
+func runKeyImport(cfg keyConfig) error {
+	importCmd := suiCommand("keytool", "import", "--alias", cfg.Alias, "ed25519", "--json")
+	importCmd.Stdin = strings.NewReader(cfg.Key + "\n")
+	return importCmd.Run()
+}
@@ -0,0 +1,161 @@
+package sui
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"efctl/pkg/secrets"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testKey     = "suiprivkey1qzgv6g33hpr66xkvu94lff8l3smw9ggq8w54rvkse7cdxy0yjjsh7dxgser" // gitleaks:allow
+	testAddress = "0x1cde4f2de0639971fbb9261591f4bbe8d100b695dddae5408e79df84ad2ba05a"
+)
+
+func TestParseKeytoolList(t *testing.T) {
+	out := []byte(`[{"alias":"ef-admin","suiAddress":"0xabc","publicBase64Key":"AAA","keyScheme":"ed25519","flag":0,"peerId":null}]`)
+
+	entries, err := parseKeytoolList(out)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "ef-admin", entries[0].Alias)
+	assert.Equal(t, "0xabc", entries[0].Address)
+}
+
+func TestParseKeytoolList_Invalid(t *testing.T) {
+	_, err := parseKeytoolList([]byte("not json"))
+	assert.Error(t, err)
+}
+
+func TestPlanKeyImport(t *testing.T) {
+	cfg := keyConfig{Role: "Admin", Key: testKey, Alias: "ef-admin"}
+
+	tests := []struct {
+		name     string
+		address  string
+		existing []keystoreEntry
+		want     keyAction
+		oldAlias string
+	}{
+		{name: "empty keystore", address: testAddress, want: keyActionImport},
+		{name: "same key same alias", address: testAddress, existing: []keystoreEntry{{Alias: "ef-admin", Address: strings.ToUpper(testAddress[:4]) + testAddress[4:]}}, want: keyActionSkip},
+		{name: "alias holds other key", address: testAddress, existing: []keystoreEntry{{Alias: "ef-admin", Address: "0xother"}}, want: keyActionReplace},
+		{name: "key under other alias", address: testAddress, existing: []keystoreEntry{{Alias: "old-admin", Address: testAddress}}, want: keyActionRename, oldAlias: "old-admin"},
+		{name: "unknown address with alias", address: "", existing: []keystoreEntry{{Alias: "ef-admin", Address: testAddress}}, want: keyActionReplace},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			action, oldAlias := planKeyImport(cfg, tt.address, tt.existing)
+			assert.Equal(t, tt.want, action)
+			assert.Equal(t, tt.oldAlias, oldAlias)
+		})
+	}
+}
+
+func TestKeyImportSummary_String(t *testing.T) {
+	s := KeyImportSummary{Imported: []string{"a"}, Skipped: []string{"b", "c"}}
+	assert.Equal(t, "1 imported, 0 updated, 2 skipped, 0 failed", s.String())
+}
+
+func TestImportKeys_SkipsExistingAlias(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("shell script stub requires a POSIX shell")
+	}
+	binDir := t.TempDir()
+	calls := filepath.Join(binDir, "calls")
+	script := `#!/bin/sh
+printf '%s\n' "$*" >> ` + calls + `
+if [ "$1 $2" = "keytool list" ]; then
+	echo '[{"alias":"ef-admin","suiAddress":"` + testAddress + `"}]'
+fi
+`
+	require.NoError(t, os.WriteFile(filepath.Join(binDir, "sui"), []byte(script), 0755))
+	t.Setenv("PATH", binDir)
+
+	summary := importKeys([]keyConfig{
+		{Role: "Admin", Key: testKey, Alias: "ef-admin"},
+		{Role: "Player A", Key: testKey + "x", Alias: "ef-player-a"},
+	})
+
+	assert.Equal(t, []string{"ef-admin"}, summary.Skipped)
+	assert.Equal(t, []string{"ef-player-a"}, summary.Imported)
+
+	data, err := os.ReadFile(calls)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "import --alias ef-admin")
+	assert.Contains(t, string(data), "import --alias ef-player-a")
+}
+
+func TestExtractKeyConfigs_AnyRole(t *testing.T) {
+	envPath := filepath.Join(t.TempDir(), ".env")
+	content := "ADMIN_PRIVATE_KEY=suiprivkey1aaa\n" +
+		"PLAYER_A_PRIVATE_KEY=suiprivkey1bbb\n" +
+		"PLAYER_C_PRIVATE_KEY=suiprivkey1ccc\n" +
+		"ADMIN_ADDRESS=0xabc\n" +
+		"# PLAYER_D_PRIVATE_KEY=suiprivkey1ddd\n"
+	require.NoError(t, os.WriteFile(envPath, []byte(content), 0600))
+
+	configs, err := extractKeyConfigs(envPath)
+	require.NoError(t, err)
+
+	assert.Equal(t, []keyConfig{
+		{Role: "Admin", Key: "suiprivkey1aaa", Alias: "ef-admin"},
+		{Role: "Player A", Key: "suiprivkey1bbb", Alias: "ef-player-a"},
+		{Role: "Player C", Key: "suiprivkey1ccc", Alias: "ef-player-c"},
+	}, configs)
+}
+
+type mapStore map[string]string
+
+func (m mapStore) Get(service, user string) (string, error) {
+	if v, ok := m[user]; ok {
+		return v, nil
+	}
+	return "", errors.New("not found")
+}
+
+func (m mapStore) Set(service, user, secret string) error {
+	m[user] = secret
+	return nil
+}
+
+func TestExtractKeyConfigs_ReadsBlankKeysFromKeyring(t *testing.T) {
+	envPath := filepath.Join(t.TempDir(), ".env")
+	require.NoError(t, os.WriteFile(envPath, []byte("ADMIN_PRIVATE_KEY=suiprivkey1aaa\nPLAYER_A_PRIVATE_KEY=suiprivkey1bbb\n"), 0600))
+
+	store := mapStore{}
+	old := secrets.Default
+	secrets.Default = store
+	defer func() { secrets.Default = old }()
+
+	_, err := secrets.Import(store, envPath)
+	require.NoError(t, err)
+
+	configs, err := extractKeyConfigs(envPath)
+	require.NoError(t, err)
+	assert.Equal(t, []keyConfig{
+		{Role: "Admin", Key: "suiprivkey1aaa", Alias: "ef-admin"},
+		{Role: "Player A", Key: "suiprivkey1bbb", Alias: "ef-player-a"},
+	}, configs)
+}
+
+func TestRoleForEnvPrefix(t *testing.T) {
+	tests := []struct{ prefix, role, alias string }{
+		{"ADMIN", "Admin", "ef-admin"},
+		{"PLAYER_B", "Player B", "ef-player-b"},
+		{"GAS_BUDDY_2", "Gas Buddy 2", "ef-gas-buddy-2"},
+	}
+	for _, tt := range tests {
+		role, alias := RoleForEnvPrefix(tt.prefix)
+		assert.Equal(t, tt.role, role)
+		assert.Equal(t, tt.alias, alias)
+	}
+}
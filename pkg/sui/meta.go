@@ -0,0 +1,87 @@
+package sui
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"efctl/pkg/ui"
+)
+
+// MetaFileName is the workspace-relative file that records deploy-time metadata.
+const MetaFileName = ".efctl-meta.json"
+
+// DeployMeta captures the toolchain state at the time `env up` deployed the world.
+type DeployMeta struct {
+	SuiVersion string    `json:"sui_version"`
+	DeployedAt time.Time `json:"deployed_at"`
+}
+
+// WriteDeployMeta persists meta to <workspace>/.efctl-meta.json.
+func WriteDeployMeta(workspace string, meta DeployMeta) error {
+	data, err := json.MarshalIndent(meta, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode deploy metadata: %w", err)
+	}
+	path := filepath.Join(workspace, MetaFileName)
+	if err := os.WriteFile(path, append(data, '\n'), 0600); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
+
+// ReadDeployMeta loads <workspace>/.efctl-meta.json. A missing file is reported
+// as an error satisfying os.IsNotExist.
+func ReadDeployMeta(workspace string) (*DeployMeta, error) {
+	path := filepath.Join(workspace, MetaFileName)
+	data, err := os.ReadFile(path) // #nosec G304 -- path is filepath.Join(workspace, hardcoded file name)
+	if err != nil {
+		return nil, err
+	}
+	var meta DeployMeta
+	if err := json.Unmarshal(data, &meta); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return &meta, nil
+}
+
+// RecordDeployMeta captures the installed sui version and stores it in the
+// workspace. Nothing is recorded when sui is not installed.
+func RecordDeployMeta(workspace string) error {
+	if !IsSuiInstalled() {
+		return nil
+	}
+	version, err := Version()
+	if err != nil {
+		return err
+	}
+	return WriteDeployMeta(workspace, DeployMeta{SuiVersion: version, DeployedAt: time.Now().UTC()})
+}
+
+// VersionDrift compares the recorded deploy-time sui version with the installed
+// one. It returns both versions and whether they differ; drift is only reported
+// when both versions are known.
+func VersionDrift(workspace string) (deployed, installed string, drift bool) {
+	meta, err := ReadDeployMeta(workspace)
+	if err != nil || meta.SuiVersion == "" || !IsSuiInstalled() {
+		return "", "", false
+	}
+	installed, err = Version()
+	if err != nil {
+		return meta.SuiVersion, "", false
+	}
+	return meta.SuiVersion, installed, meta.SuiVersion != installed
+}
+
+// WarnOnVersionDrift prints a warning when the installed sui differs from the
+// version that was used to deploy the environment.
+func WarnOnVersionDrift(workspace string) {
+	deployed, installed, drift := VersionDrift(workspace)
+	if !drift {
+		return
+	}
+	ui.Warn.Println(fmt.Sprintf("Installed sui (%s) differs from the version used to deploy this environment (%s).", installed, deployed))
+	ui.Warn.Println("Publishing or importing keys may fail; re-run `efctl env up` or install the matching sui version.")
+}
@@ -0,0 +1,112 @@
+package sui
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubSuiVersion places a fake sui binary that reports version on PATH.
+func stubSuiVersion(t *testing.T, version string) {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("shell script stub requires a POSIX shell")
+	}
+	binDir := t.TempDir()
+	script := "#!/bin/sh\necho 'sui " + version + "'\n"
+	require.NoError(t, os.WriteFile(filepath.Join(binDir, "sui"), []byte(script), 0755))
+	t.Setenv("PATH", binDir)
+}
+
+func TestVersion_TrimsPrefix(t *testing.T) {
+	stubSuiVersion(t, "1.66.1-abc123")
+
+	version, err := Version()
+	require.NoError(t, err)
+	assert.Equal(t, "1.66.1-abc123", version)
+}
+
+func TestVersion_NotInstalled(t *testing.T) {
+	t.Setenv("PATH", t.TempDir())
+
+	_, err := Version()
+	assert.Error(t, err)
+}
+
+func TestVersionAtLeast(t *testing.T) {
+	tests := []struct {
+		version  string
+		atLeast  bool
+		parsable bool
+	}{
+		{"1.66.1-abc123", true, true},
+		{"1.66.0", true, true},
+		{"1.70.2", true, true},
+		{"2.0.0", true, true},
+		{"1.65.3", false, true},
+		{"0.99.0", false, true},
+		{"", false, false},
+		{"unknown", false, false},
+		{"1.x.0", false, false},
+	}
+	for _, tt := range tests {
+		atLeast, ok := VersionAtLeast(tt.version, 1, 66)
+		assert.Equal(t, tt.parsable, ok, tt.version)
+		assert.Equal(t, tt.atLeast, atLeast, tt.version)
+	}
+}
+
+func TestDeployMeta_RoundTrip(t *testing.T) {
+	workspace := t.TempDir()
+	deployedAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	require.NoError(t, WriteDeployMeta(workspace, DeployMeta{SuiVersion: "1.66.1", DeployedAt: deployedAt}))
+
+	info, err := os.Stat(filepath.Join(workspace, MetaFileName))
+	require.NoError(t, err)
+	if runtime.GOOS != "windows" {
+		assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	}
+
+	meta, err := ReadDeployMeta(workspace)
+	require.NoError(t, err)
+	assert.Equal(t, "1.66.1", meta.SuiVersion)
+	assert.True(t, deployedAt.Equal(meta.DeployedAt))
+}
+
+func TestReadDeployMeta_Missing(t *testing.T) {
+	_, err := ReadDeployMeta(t.TempDir())
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestVersionDrift(t *testing.T) {
+	workspace := t.TempDir()
+	require.NoError(t, WriteDeployMeta(workspace, DeployMeta{SuiVersion: "1.65.0"}))
+	stubSuiVersion(t, "1.66.1")
+
+	deployed, installed, drift := VersionDrift(workspace)
+	assert.True(t, drift)
+	assert.Equal(t, "1.65.0", deployed)
+	assert.Equal(t, "1.66.1", installed)
+}
+
+func TestVersionDrift_Matching(t *testing.T) {
+	workspace := t.TempDir()
+	require.NoError(t, WriteDeployMeta(workspace, DeployMeta{SuiVersion: "1.66.1"}))
+	stubSuiVersion(t, "1.66.1")
+
+	_, _, drift := VersionDrift(workspace)
+	assert.False(t, drift)
+}
+
+func TestVersionDrift_NoMetadata(t *testing.T) {
+	stubSuiVersion(t, "1.66.1")
+
+	_, _, drift := VersionDrift(t.TempDir())
+	assert.False(t, drift)
+}
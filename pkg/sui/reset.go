@@ -0,0 +1,211 @@
+package sui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"efctl/pkg/ui"
+
+	"gopkg.in/yaml.v3"
+)
+
+// LocalEnvAlias is the sui client environment efctl creates for the local network.
+const LocalEnvAlias = "ef-localhost"
+
+// ManagedAliases are the keystore aliases for the built-in world-contracts/.env roles.
+var ManagedAliases = []string{"ef-admin", "ef-player-a", "ef-player-b"}
+
+// ResetSui removes the efctl-managed key aliases and the ef-localhost
+// environment from the sui client configuration. The environment is removed by
+// editing client.yaml directly because the sui CLI cannot always delete it.
+// Only aliases efctl creates are touched: the built-in roles and those derived
+// from the *_PRIVATE_KEY entries in the workspace world-contracts/.env.
+func ResetSui(workspace string) error {
+	managed := managedAliases(workspace)
+	managedAddrs := map[string]bool{}
+	if IsSuiInstalled() {
+		if entries, err := listKeystore(); err == nil {
+			for _, e := range entries {
+				if managed[e.Alias] {
+					managedAddrs[strings.ToLower(e.Address)] = true
+				}
+			}
+		}
+		aliases := make([]string, 0, len(managed))
+		for alias := range managed {
+			aliases = append(aliases, alias)
+		}
+		sort.Strings(aliases)
+		for _, alias := range aliases {
+			if err := suiCommand("client", "remove-address", alias).Run(); err == nil {
+				ui.Info.Println("Removed sui alias: " + alias)
+			}
+		}
+	} else {
+		ui.Warn.Println("Sui CLI not found; only the client configuration file will be edited.")
+	}
+
+	path := SuiConfigPath()
+	if !SuiConfigExists() {
+		ui.Info.Println("No sui client configuration found at " + path)
+		return nil
+	}
+
+	removed, err := removeClientEnv(path, LocalEnvAlias, managedAddrs)
+	if err != nil {
+		return err
+	}
+	if removed {
+		ui.Info.Println(fmt.Sprintf("Removed %s environment from %s", LocalEnvAlias, path))
+	} else {
+		ui.Info.Println(fmt.Sprintf("No %s environment present in %s", LocalEnvAlias, path))
+	}
+	return nil
+}
+
+// managedAliases returns the keystore aliases efctl creates for workspace: the
+// built-in roles plus one per *_PRIVATE_KEY entry in world-contracts/.env. A
+// missing or unreadable .env leaves just the built-in roles.
+func managedAliases(workspace string) map[string]bool {
+	managed := make(map[string]bool, len(ManagedAliases))
+	for _, a := range ManagedAliases {
+		managed[a] = true
+	}
+	for _, a := range envKeyAliases(filepath.Join(workspace, "world-contracts", ".env")) {
+		managed[a] = true
+	}
+	return managed
+}
+
+// envKeyAliases derives the keystore alias for every *_PRIVATE_KEY entry in the
+// .env file at path, whether or not the key itself is present.
+func envKeyAliases(path string) []string {
+	data, err := os.ReadFile(path) // #nosec G304 -- path is constructed internally under the workspace
+	if err != nil {
+		return nil
+	}
+	var aliases []string
+	for _, line := range strings.Split(string(data), "\n") {
+		if match := privateKeyNameRegex.FindStringSubmatch(strings.TrimSpace(line)); match != nil {
+			_, alias := RoleForEnvPrefix(match[1])
+			aliases = append(aliases, alias)
+		}
+	}
+	return aliases
+}
+
+// removeClientEnv deletes the envs entry with the given alias from the sui
+// client.yaml at path. If it was the active env, active_env falls back to the
+// first remaining env; an active_address in clearAddrs is reset to null.
+// A backup of the original file is written to path+".bak".
+func removeClientEnv(path, alias string, clearAddrs map[string]bool) (bool, error) {
+	data, err := os.ReadFile(path) // #nosec G304 -- path is the sui client config under the user's home directory
+	if err != nil {
+		return false, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return false, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return false, fmt.Errorf("unexpected structure in %s", path)
+	}
+	root := doc.Content[0]
+
+	changed := false
+	envs := mappingValue(root, "envs")
+	var remaining []*yaml.Node
+	if envs != nil && envs.Kind == yaml.SequenceNode {
+		for _, env := range envs.Content {
+			if a := mappingValue(env, "alias"); a != nil && a.Value == alias {
+				changed = true
+				continue
+			}
+			remaining = append(remaining, env)
+		}
+		envs.Content = remaining
+	}
+
+	if active := mappingValue(root, "active_env"); active != nil && active.Value == alias {
+		if len(remaining) > 0 {
+			if a := mappingValue(remaining[0], "alias"); a != nil {
+				active.Value = a.Value
+				active.Tag = "!!str"
+			}
+		} else {
+			setNull(active)
+		}
+		changed = true
+	}
+
+	if addr := mappingValue(root, "active_address"); addr != nil && clearAddrs[strings.ToLower(addr.Value)] {
+		setNull(addr)
+		changed = true
+	}
+
+	if !changed {
+		return false, nil
+	}
+
+	out, err := yaml.Marshal(&doc)
+	if err != nil {
+		return false, fmt.Errorf("failed to encode %s: %w", path, err)
+	}
+	if err := os.WriteFile(path+".bak", data, 0600); err != nil {
+		return false, fmt.Errorf("failed to back up %s: %w", path, err)
+	}
+	if err := os.WriteFile(path, out, 0600); err != nil {
+		return false, fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return true, nil
+}
+
+// hasClientEnv reports whether the sui client.yaml at path lists an env with
+// the given alias.
+func hasClientEnv(path, alias string) (bool, error) {
+	data, err := os.ReadFile(path) // #nosec G304 -- path is the sui client config under the user's home directory
+	if err != nil {
+		return false, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return false, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	if len(doc.Content) == 0 {
+		return false, nil
+	}
+	envs := mappingValue(doc.Content[0], "envs")
+	if envs == nil || envs.Kind != yaml.SequenceNode {
+		return false, nil
+	}
+	for _, env := range envs.Content {
+		if a := mappingValue(env, "alias"); a != nil && a.Value == alias {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func setNull(node *yaml.Node) {
+	node.Kind = yaml.ScalarNode
+	node.Tag = "!!null"
+	node.Value = "~"
+	node.Style = 0
+}
@@ -0,0 +1,151 @@
+package sui
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+const testClientYAML = `---
+keystore:
+  File: /home/user/.sui/sui_config/sui.keystore
+envs:
+  - alias: localnet
+    rpc: "http://127.0.0.1:9000"
+    ws: ~
+    basic_auth: ~
+  - alias: ef-localhost
+    rpc: "http://localhost:9000"
+    ws: ~
+    basic_auth: ~
+active_env: ef-localhost
+active_address: "0xABC"
+`
+
+type testClientConfig struct {
+	Envs []struct {
+		Alias string `yaml:"alias"`
+	} `yaml:"envs"`
+	ActiveEnv     *string `yaml:"active_env"`
+	ActiveAddress *string `yaml:"active_address"`
+	Keystore      map[string]string
+}
+
+func readTestClientConfig(t *testing.T, path string) testClientConfig {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var cfg testClientConfig
+	require.NoError(t, yaml.Unmarshal(data, &cfg))
+	return cfg
+}
+
+func TestRemoveClientEnv_RemovesEnvAndSwitchesActive(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "client.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(testClientYAML), 0600))
+
+	removed, err := removeClientEnv(path, LocalEnvAlias, map[string]bool{"0xabc": true})
+	require.NoError(t, err)
+	assert.True(t, removed)
+
+	cfg := readTestClientConfig(t, path)
+	require.Len(t, cfg.Envs, 1)
+	assert.Equal(t, "localnet", cfg.Envs[0].Alias)
+	require.NotNil(t, cfg.ActiveEnv)
+	assert.Equal(t, "localnet", *cfg.ActiveEnv)
+	assert.Nil(t, cfg.ActiveAddress)
+	assert.Equal(t, "/home/user/.sui/sui_config/sui.keystore", cfg.Keystore["File"])
+
+	backup, err := os.ReadFile(path + ".bak")
+	require.NoError(t, err)
+	assert.Equal(t, testClientYAML, string(backup))
+}
+
+func TestRemoveClientEnv_KeepsUnmanagedActiveAddress(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "client.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(testClientYAML), 0600))
+
+	_, err := removeClientEnv(path, LocalEnvAlias, nil)
+	require.NoError(t, err)
+
+	cfg := readTestClientConfig(t, path)
+	require.NotNil(t, cfg.ActiveAddress)
+	assert.Equal(t, "0xABC", *cfg.ActiveAddress)
+}
+
+func TestRemoveClientEnv_NoMatch(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "client.yaml")
+	content := "envs:\n  - alias: localnet\n    rpc: \"http://127.0.0.1:9000\"\nactive_env: localnet\n"
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+
+	removed, err := removeClientEnv(path, LocalEnvAlias, nil)
+	require.NoError(t, err)
+	assert.False(t, removed)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, content, string(data), "file should be untouched when nothing changes")
+	_, err = os.Stat(path + ".bak")
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestRemoveClientEnv_LastEnvClearsActive(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "client.yaml")
+	content := "envs:\n  - alias: ef-localhost\n    rpc: \"http://localhost:9000\"\nactive_env: ef-localhost\n"
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+
+	removed, err := removeClientEnv(path, LocalEnvAlias, nil)
+	require.NoError(t, err)
+	assert.True(t, removed)
+
+	cfg := readTestClientConfig(t, path)
+	assert.Empty(t, cfg.Envs)
+	assert.Nil(t, cfg.ActiveEnv)
+}
+
+func TestRemoveClientEnv_InvalidYAML(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "client.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("- just\n- a list\n"), 0600))
+
+	_, err := removeClientEnv(path, LocalEnvAlias, nil)
+	assert.Error(t, err)
+}
+
+func TestHasClientEnv(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "client.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(testClientYAML), 0600))
+
+	present, err := hasClientEnv(path, LocalEnvAlias)
+	require.NoError(t, err)
+	assert.True(t, present)
+
+	present, err = hasClientEnv(path, "devnet")
+	require.NoError(t, err)
+	assert.False(t, present)
+
+	_, err = hasClientEnv(filepath.Join(t.TempDir(), "missing.yaml"), LocalEnvAlias)
+	assert.Error(t, err)
+}
+
+func TestManagedAliases_OnlyEfctlAliases(t *testing.T) {
+	ws := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(ws, "world-contracts"), 0755))
+	env := "ADMIN_PRIVATE_KEY=suiprivkey1abc\nPLAYER_C_PRIVATE_KEY=\nSUI_NETWORK=localnet\n"
+	require.NoError(t, os.WriteFile(filepath.Join(ws, "world-contracts", ".env"), []byte(env), 0600))
+
+	managed := managedAliases(ws)
+	assert.True(t, managed["ef-admin"])
+	assert.True(t, managed["ef-player-a"])
+	assert.True(t, managed["ef-player-c"], "aliases derived from .env keys are managed even when the key is in the keyring")
+	assert.False(t, managed["ef-mainnet"], "a user alias starting with ef- must survive reset")
+}
+
+func TestManagedAliases_MissingEnv(t *testing.T) {
+	managed := managedAliases(t.TempDir())
+	assert.Len(t, managed, len(ManagedAliases))
+	assert.False(t, managed["ef-mainnet"])
+}
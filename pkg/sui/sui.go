@@ -7,9 +7,11 @@ import (
 	"os/exec"
 	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"efctl/pkg/env"
+	"efctl/pkg/secrets"
 	"efctl/pkg/ui"
 )
 
@@ -39,6 +41,7 @@ func (e *DefaultExecutor) LookPath(file string) (string, error) {
 
 func (e *DefaultExecutor) Run(name string, args ...string) error {
 	cmd := exec.Command(name, args...) // #nosec G204
+	ui.Command(cmd.Args[0], cmd.Args[1:]...)
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
 	return cmd.Run()
@@ -46,19 +49,27 @@ func (e *DefaultExecutor) Run(name string, args ...string) error {
 
 func (e *DefaultExecutor) RunWithStdin(stdin string, name string, args ...string) error {
 	cmd := exec.Command(name, args...) // #nosec G204
+	ui.Command(cmd.Args[0], cmd.Args[1:]...)
 	cmd.Stdin = strings.NewReader(stdin)
 	return cmd.Run()
 }
 
 func (e *DefaultExecutor) ExecCapture(name string, args ...string) (string, error) {
 	cmd := exec.Command(name, args...) // #nosec G204
+	ui.Command(cmd.Args[0], cmd.Args[1:]...)
 	out, err := cmd.CombinedOutput()
 	return string(out), err
 }
 
-var adminKeyRegex = regexp.MustCompile(`^ADMIN_PRIVATE_KEY=(suiprivkey[a-z0-9]+)`)
-var playerAKeyRegex = regexp.MustCompile(`^PLAYER_A_PRIVATE_KEY=(suiprivkey[a-z0-9]+)`)
-var playerBKeyRegex = regexp.MustCompile(`^PLAYER_B_PRIVATE_KEY=(suiprivkey[a-z0-9]+)`)
+// privateKeyRegex matches any <ROLE>_PRIVATE_KEY entry holding a bech32 sui private key.
+var privateKeyRegex = regexp.MustCompile(`^([A-Z][A-Z0-9_]*)_PRIVATE_KEY=(suiprivkey[a-z0-9]+)`)
+
+// blankPrivateKeyRegex matches a <ROLE>_PRIVATE_KEY entry whose value was moved
+// to the OS keyring by `efctl env secrets import`.
+var blankPrivateKeyRegex = regexp.MustCompile(`^([A-Z][A-Z0-9_]*)_PRIVATE_KEY=\s*$`)
+
+// privateKeyNameRegex matches any <ROLE>_PRIVATE_KEY entry regardless of its value.
+var privateKeyNameRegex = regexp.MustCompile(`^([A-Z][A-Z0-9_]*)_PRIVATE_KEY=`)
 
 // SuiConfigPath returns the default sui client config path,
 // resolved relative to the current user's home directory.
@@ -107,27 +118,63 @@ func InstallSuiUp() error {
 
 	ui.Info.Println("Executing verified installer...")
 	cmd := exec.Command("bash", scriptPath) // #nosec G204
+	ui.Command(cmd.Args[0], cmd.Args[1:]...)
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
 	return cmd.Run()
 }
 
 func IsSuiInstalled() bool {
-	_, err := exec.LookPath("sui")
+	_, err := exec.LookPath(Binary())
 	return err == nil
 }
 
+// Version returns the version reported by the host sui binary, with the
+// leading "sui " stripped, e.g. "1.66.1-abc123".
+func Version() (string, error) {
+	out, err := suiCommand("--version").Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to run sui --version: %w", err)
+	}
+	return strings.TrimPrefix(strings.TrimSpace(string(out)), "sui "), nil
+}
+
+// VersionAtLeast reports whether version (as returned by Version) is at least
+// major.minor. The second result is false when version cannot be parsed.
+func VersionAtLeast(version string, major, minor int) (atLeast, ok bool) {
+	parts := strings.SplitN(version, ".", 3)
+	if len(parts) < 2 {
+		return false, false
+	}
+	gotMajor, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return false, false
+	}
+	gotMinor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return false, false
+	}
+	if gotMajor != major {
+		return gotMajor > major, true
+	}
+	return gotMinor >= minor, true
+}
+
 func InstallSui() error {
 	ui.Info.Println("Installing sui via suiup...")
 	cmd := exec.Command("suiup", "install", "sui", "-y")
+	ui.Command(cmd.Args[0], cmd.Args[1:]...)
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
 	return cmd.Run()
 }
 
-func ConfigureSui(workspace string) error {
+// ConfigureSui points the sui client at the local environment and reconciles
+// the workspace keys with the keystore. The returned summary is nil when sui
+// is not installed or no keys could be read.
+func ConfigureSui(workspace string) (*KeyImportSummary, error) {
 	if !IsSuiInstalled() {
-		return nil
+		return nil, nil
 	}
 
 	ui.Info.Println("Configuring Sui client...")
@@ -135,34 +182,67 @@ func ConfigureSui(workspace string) error {
 	// 1. Add/Update environment
 	// We use ef-localhost to avoid overriding existing localnet if any
 	// We try to remove it first to ensure the faucet URL is correctly applied if it already existed
-	_ = exec.Command("sui", "client", "remove-env", "--alias", "ef-localhost").Run()
-	_ = exec.Command("sui", "client", "new-env", "--alias", "ef-localhost", "--rpc", "http://localhost:9000").Run()
-
-	// Switch to it
-	if err := exec.Command("sui", "client", "switch", "--env", "ef-localhost").Run(); err != nil {
-		return fmt.Errorf("failed to switch to ef-localhost: %w", err)
+	_ = suiCommand("client", "remove-env", "--alias", LocalEnvAlias).Run()
+	if _, err := EnsureLocalEnv(); err != nil {
+		return nil, err
+	}
+	if err := UseLocalEnv(); err != nil {
+		return nil, err
 	}
 
 	// 2. Import keys from .env
+	summary, err := ImportWorkspaceKeys(workspace)
+	if err != nil {
+		ui.Warn.Println("Could not extract keys from .env: " + err.Error())
+		return nil, nil
+	}
+
+	ui.Success.Println("Sui client configured with ef-localhost environment and workspace keys (" + summary.String() + ").")
+	return summary, nil
+}
+
+// ImportWorkspaceKeys reconciles the *_PRIVATE_KEY entries in
+// world-contracts/.env with the sui keystore without touching the client
+// environments.
+func ImportWorkspaceKeys(workspace string) (*KeyImportSummary, error) {
 	envPath := filepath.Join(workspace, "world-contracts", ".env")
 	configs, err := extractKeyConfigs(envPath)
 	if err != nil {
-		ui.Warn.Println("Could not extract keys from .env: " + err.Error())
-		return nil
+		return nil, err
 	}
+	summary := importKeys(configs)
+	return &summary, nil
+}
 
-	for _, cfg := range configs {
-		// Import key via stdin to avoid exposing it in process arguments (ps aux / /proc/pid/cmdline)
-		ui.Info.Println(fmt.Sprintf("Importing key for %s as alias: %s", cfg.Role, cfg.Alias))
-		importCmd := exec.Command("sui", "keytool", "import", "--alias", cfg.Alias, "ed25519", "--json") // #nosec G204
-		importCmd.Stdin = strings.NewReader(cfg.Key + "\n")
-		if err := importCmd.Run(); err != nil {
-			// If already exists, we might want to update or ignore. For now, ignore but log
-			ui.Warn.Println(fmt.Sprintf("Failed to import key for %s (possibly already exists): %v", cfg.Role, err))
+// EnsureLocalEnv creates the ef-localhost client environment when it is
+// missing from client.yaml and reports whether it had to be created. The
+// active environment is left alone; see UseLocalEnv.
+func EnsureLocalEnv() (bool, error) {
+	if !IsSuiInstalled() {
+		return false, fmt.Errorf("sui CLI not found")
+	}
+	present := false
+	if SuiConfigExists() {
+		var err error
+		present, err = hasClientEnv(SuiConfigPath(), LocalEnvAlias)
+		if err != nil {
+			return false, err
 		}
 	}
+	if present {
+		return false, nil
+	}
+	if err := suiCommand("client", "new-env", "--alias", LocalEnvAlias, "--rpc", "http://localhost:9000").Run(); err != nil {
+		return false, fmt.Errorf("failed to create %s: %w", LocalEnvAlias, err)
+	}
+	return true, nil
+}
 
-	ui.Success.Println("Sui client configured with ef-localhost environment and workspace keys.")
+// UseLocalEnv makes ef-localhost the active client environment.
+func UseLocalEnv() error {
+	if err := suiCommand("client", "switch", "--env", LocalEnvAlias).Run(); err != nil {
+		return fmt.Errorf("failed to switch to %s: %w", LocalEnvAlias, err)
+	}
 	return nil
 }
 
@@ -174,15 +254,12 @@ func TeardownSui() error {
 	ui.Info.Println("Tearing down Sui client configuration...")
 
 	// Remove aliases
-	aliases := []string{"ef-admin", "ef-player-a", "ef-player-b"}
-	for _, alias := range aliases {
-		// #nosec G204 -- alias array contains safe hardcoded strings
-		_ = exec.Command("sui", "client", "remove-address", alias).Run()
+	for _, alias := range ManagedAliases {
+		_ = suiCommand("client", "remove-address", alias).Run()
 	}
 
-	// Sui CLI doesn't have a direct 'remove-env' command easily accessible via simple 'sui client remove-env',
-	// but we've switched to others if needed. For now, we mainly care about the aliases and the env being inactive.
-	// Some versions might support removing from config file, but we'll stick to what's safe.
+	// The ef-localhost env is left in place here; `efctl env sui reset` removes
+	// it by editing the client config directly (see ResetSui).
 
 	ui.Success.Println("Sui client environment and aliases cleaned up.")
 	return nil
@@ -206,17 +283,33 @@ func extractKeyConfigs(envPath string) ([]keyConfig, error) {
 	scanner := bufio.NewScanner(file)
 	for scanner.Scan() {
 		line := scanner.Text()
-		if match := adminKeyRegex.FindStringSubmatch(line); match != nil {
-			configs = append(configs, keyConfig{Role: "Admin", Key: match[1], Alias: "ef-admin"})
-		} else if match := playerAKeyRegex.FindStringSubmatch(line); match != nil {
-			configs = append(configs, keyConfig{Role: "Player A", Key: match[1], Alias: "ef-player-a"})
-		} else if match := playerBKeyRegex.FindStringSubmatch(line); match != nil {
-			configs = append(configs, keyConfig{Role: "Player B", Key: match[1], Alias: "ef-player-b"})
+		if match := privateKeyRegex.FindStringSubmatch(line); match != nil {
+			role, alias := RoleForEnvPrefix(match[1])
+			configs = append(configs, keyConfig{Role: role, Key: match[2], Alias: alias})
+		} else if match := blankPrivateKeyRegex.FindStringSubmatch(line); match != nil {
+			if key := secrets.Lookup(secrets.Default, envPath, match[1]+"_PRIVATE_KEY"); key != "" {
+				role, alias := RoleForEnvPrefix(match[1])
+				configs = append(configs, keyConfig{Role: role, Key: key, Alias: alias})
+			}
 		}
 	}
 	return configs, scanner.Err()
 }
 
+// RoleForEnvPrefix derives a display role and keystore alias from the prefix
+// of a <ROLE>_PRIVATE_KEY variable, e.g. "PLAYER_C" → ("Player C", "ef-player-c").
+func RoleForEnvPrefix(prefix string) (role, alias string) {
+	words := strings.Split(strings.ToLower(prefix), "_")
+	titled := make([]string, 0, len(words))
+	for _, w := range words {
+		if w == "" {
+			continue
+		}
+		titled = append(titled, strings.ToUpper(w[:1])+w[1:])
+	}
+	return strings.Join(titled, " "), "ef-" + strings.Join(words, "-")
+}
+
 // CallMove executes a 'sui client call' and returns the output.
 func CallMove(args []string, gasBuddy string) (string, error) {
 	executor := &DefaultExecutor{}
@@ -226,5 +319,5 @@ func CallMove(args []string, gasBuddy string) (string, error) {
 	}
 	fullArgs = append(fullArgs, args...)
 
-	return executor.ExecCapture("sui", fullArgs...)
+	return executor.ExecCapture(Binary(), fullArgs...)
 }
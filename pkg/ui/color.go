@@ -0,0 +1,60 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+	"github.com/pterm/pterm"
+	"golang.org/x/term"
+)
+
+// Color modes accepted by the global --color flag.
+const (
+	ColorAuto   = "auto"
+	ColorAlways = "always"
+	ColorNever  = "never"
+)
+
+// ColorModes lists the valid --color values.
+var ColorModes = []string{ColorAuto, ColorAlways, ColorNever}
+
+// ResolveColor reports whether ANSI colour should be emitted for mode. In auto
+// mode colour is disabled when NO_COLOR is set or stdout is not a terminal;
+// always and never override both.
+func ResolveColor(mode, noColor string, isTTY bool) (bool, error) {
+	switch mode {
+	case ColorAlways:
+		return true, nil
+	case ColorNever:
+		return false, nil
+	case ColorAuto, "":
+		return noColor == "" && isTTY, nil
+	default:
+		return false, fmt.Errorf("invalid color mode %q: must be one of auto, always, never", mode)
+	}
+}
+
+// SetColorMode enables or disables colour for both pterm output and the
+// dashboard's lipgloss styles.
+func SetColorMode(mode string) error {
+	enabled, err := ResolveColor(mode, os.Getenv("NO_COLOR"), stdoutIsTerminal())
+	if err != nil {
+		return err
+	}
+	if enabled {
+		pterm.EnableColor()
+		if mode == ColorAlways {
+			lipgloss.SetColorProfile(termenv.TrueColor)
+		}
+	} else {
+		pterm.DisableColor()
+		lipgloss.SetColorProfile(termenv.Ascii)
+	}
+	return nil
+}
+
+func stdoutIsTerminal() bool {
+	return term.IsTerminal(int(os.Stdout.Fd())) // #nosec G115 -- file descriptors fit in an int
+}
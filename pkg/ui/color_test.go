@@ -0,0 +1,33 @@
+package ui
+
+import "testing"
+
+func TestResolveColor(t *testing.T) {
+	tests := []struct {
+		name    string
+		mode    string
+		noColor string
+		isTTY   bool
+		want    bool
+		wantErr bool
+	}{
+		{"auto on terminal", ColorAuto, "", true, true, false},
+		{"auto when piped", ColorAuto, "", false, false, false},
+		{"auto with NO_COLOR", ColorAuto, "1", true, false, false},
+		{"empty behaves as auto", "", "", true, true, false},
+		{"always overrides NO_COLOR and pipes", ColorAlways, "1", false, true, false},
+		{"never on terminal", ColorNever, "", true, false, false},
+		{"invalid mode", "sometimes", "", true, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ResolveColor(tt.mode, tt.noColor, tt.isTTY)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ResolveColor() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ResolveColor() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
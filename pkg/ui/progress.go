@@ -0,0 +1,60 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+// ProgressJSONEnabled makes Phase and spinners emit one JSON object per line
+// on stderr, for tools that drive efctl and need machine-readable progress.
+// Human output is unchanged and stays on stdout. Set via env up --progress-json.
+var ProgressJSONEnabled bool
+
+// progressOutput is where progress events are written.
+var progressOutput io.Writer = os.Stderr
+
+var progressMu sync.Mutex
+
+// Phase statuses reported by Phase.
+const (
+	PhaseStarted = "start"
+	PhaseDone    = "done"
+	PhaseSkipped = "skipped"
+	PhaseFailed  = "failed"
+)
+
+// ProgressEvent is one line of --progress-json output. Event is "phase" for
+// phase transitions and "spinner" for spinner state changes.
+type ProgressEvent struct {
+	Event   string `json:"event"`
+	Phase   string `json:"phase,omitempty"`
+	Status  string `json:"status"`
+	Message string `json:"message,omitempty"`
+}
+
+// Phase reports that an env up phase changed status. message is optional
+// detail, such as the error for a failed phase.
+func Phase(phase, status string, message ...any) {
+	emitProgress(ProgressEvent{Event: "phase", Phase: phase, Status: status, Message: fmt.Sprint(message...)})
+}
+
+func emitProgress(ev ProgressEvent) {
+	if !ProgressJSONEnabled {
+		return
+	}
+	ev.Message = Redact(ev.Message)
+	data, err := json.Marshal(ev)
+	if err != nil {
+		return
+	}
+	progressMu.Lock()
+	defer progressMu.Unlock()
+	_, _ = progressOutput.Write(append(data, '\n'))
+}
+
+func spinnerEvent(status string, message []any) {
+	emitProgress(ProgressEvent{Event: "spinner", Status: status, Message: fmt.Sprint(message...)})
+}
@@ -0,0 +1,51 @@
+package ui
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestPhase(t *testing.T) {
+	var buf bytes.Buffer
+	oldOutput, oldEnabled := progressOutput, ProgressJSONEnabled
+	progressOutput = &buf
+	defer func() { progressOutput, ProgressJSONEnabled = oldOutput, oldEnabled }()
+
+	ProgressJSONEnabled = false
+	Phase("clone", PhaseStarted)
+	if buf.Len() != 0 {
+		t.Fatalf("expected no output when --progress-json is off, got %q", buf.String())
+	}
+
+	ProgressJSONEnabled = true
+	Phase("clone", PhaseStarted)
+	Phase("deploy", PhaseFailed, "bad key suiprivkey1qabc")
+	want := `{"event":"phase","phase":"clone","status":"start"}` + "\n" +
+		`{"event":"phase","phase":"deploy","status":"failed","message":"bad key suiprivkey[REDACTED]"}` + "\n"
+	if got := buf.String(); got != want {
+		t.Errorf("Phase() output = %q, want %q", got, want)
+	}
+}
+
+func TestSpinnerEvents(t *testing.T) {
+	var buf bytes.Buffer
+	oldOutput, oldEnabled, oldProgress := progressOutput, ProgressJSONEnabled, ProgressEnabled
+	progressOutput = &buf
+	defer func() { progressOutput, ProgressJSONEnabled, ProgressEnabled = oldOutput, oldEnabled, oldProgress }()
+
+	ProgressJSONEnabled = true
+	ProgressEnabled = false
+	s, err := Spin("Cloning world-contracts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.UpdateText("Checking out v1")
+	s.Success("Cloned")
+
+	want := `{"event":"spinner","status":"start","message":"Cloning world-contracts"}` + "\n" +
+		`{"event":"spinner","status":"update","message":"Checking out v1"}` + "\n" +
+		`{"event":"spinner","status":"success","message":"Cloned"}` + "\n"
+	if got := buf.String(); got != want {
+		t.Errorf("spinner events = %q, want %q", got, want)
+	}
+}
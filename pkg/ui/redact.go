@@ -0,0 +1,71 @@
+package ui
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// privateKeyPattern matches bech32-encoded sui private keys.
+var privateKeyPattern = regexp.MustCompile(`suiprivkey[a-z0-9]+`)
+
+// Redact masks every sui private key in s, keeping the "suiprivkey" prefix so
+// readers can still tell a key was there.
+func Redact(s string) string {
+	return privateKeyPattern.ReplaceAllString(s, "suiprivkey[REDACTED]")
+}
+
+// redactArgs formats a as Print would and redacts the result.
+func redactArgs(a []any) string {
+	return Redact(fmt.Sprint(a...))
+}
+
+// redactLineArgs formats a as Println would, without the trailing newline, and
+// redacts the result.
+func redactLineArgs(a []any) string {
+	return Redact(strings.TrimSuffix(fmt.Sprintln(a...), "\n"))
+}
+
+// RedactingWriter redacts private keys from streamed output. It holds back a
+// partial line until its newline arrives so a key split across writes is still
+// masked; call Flush once the stream ends to emit any remainder.
+type RedactingWriter struct {
+	mu  sync.Mutex
+	w   io.Writer
+	buf []byte
+}
+
+// NewRedactingWriter returns a RedactingWriter that writes to w.
+func NewRedactingWriter(w io.Writer) *RedactingWriter {
+	return &RedactingWriter{w: w}
+}
+
+func (r *RedactingWriter) Write(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.buf = append(r.buf, p...)
+	if i := bytes.LastIndexByte(r.buf, '\n'); i >= 0 {
+		if _, err := io.WriteString(r.w, Redact(string(r.buf[:i+1]))); err != nil {
+			return 0, err
+		}
+		r.buf = append(r.buf[:0], r.buf[i+1:]...)
+	}
+	return len(p), nil
+}
+
+// Flush writes any buffered partial line.
+func (r *RedactingWriter) Flush() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.buf) == 0 {
+		return nil
+	}
+	_, err := io.WriteString(r.w, Redact(string(r.buf)))
+	r.buf = r.buf[:0]
+	return err
+}
@@ -0,0 +1,64 @@
+package ui
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/pterm/pterm"
+)
+
+const testPrivateKey = "suiprivkey1qzgv6g33hpr66xkvu94lff8l3smw9ggq8w54rvkse7cdxy0yjjsh7dxgser" // gitleaks:allow
+
+func TestRedact(t *testing.T) {
+	in := "sui keytool import " + testPrivateKey + " ed25519 --alias ef-admin"
+	got := Redact(in)
+	if strings.Contains(got, testPrivateKey) {
+		t.Fatalf("Expected key to be redacted, got %q", got)
+	}
+	if want := "sui keytool import suiprivkey[REDACTED] ed25519 --alias ef-admin"; got != want {
+		t.Errorf("Redact() = %q, want %q", got, want)
+	}
+}
+
+func TestErrorPrinter_RedactsPrivateKeys(t *testing.T) {
+	var buf bytes.Buffer
+	pterm.SetDefaultOutput(&buf)
+	defer pterm.SetDefaultOutput(os.Stdout)
+
+	err := errors.New("command failed: sui keytool import " + testPrivateKey + ": exit status 1")
+	Error.Println("Sui configuration failed: " + err.Error())
+	Error.Printf("publish failed: %v", err)
+
+	output := buf.String()
+	if strings.Contains(output, testPrivateKey) || strings.Contains(output, "suiprivkey1") {
+		t.Fatalf("Expected private key to be masked, got %q", output)
+	}
+	if !strings.Contains(output, "suiprivkey[REDACTED]") {
+		t.Errorf("Expected redaction marker in output, got %q", output)
+	}
+}
+
+func TestRedactingWriter_KeySplitAcrossWrites(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewRedactingWriter(&buf)
+
+	half := len(testPrivateKey) / 2
+	for _, chunk := range []string{"ADMIN_PRIVATE_KEY=" + testPrivateKey[:half], testPrivateKey[half:] + "\nnext ", "line"} {
+		if _, err := w.Write([]byte(chunk)); err != nil {
+			t.Fatalf("Write() error: %v", err)
+		}
+	}
+	if got, want := buf.String(), "ADMIN_PRIVATE_KEY=suiprivkey[REDACTED]\n"; got != want {
+		t.Errorf("after writes = %q, want %q", got, want)
+	}
+
+	if err := w.Flush(); err != nil {
+		t.Fatalf("Flush() error: %v", err)
+	}
+	if got, want := buf.String(), "ADMIN_PRIVATE_KEY=suiprivkey[REDACTED]\nnext line"; got != want {
+		t.Errorf("after flush = %q, want %q", got, want)
+	}
+}
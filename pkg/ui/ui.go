@@ -1,6 +1,9 @@
 package ui
 
 import (
+	"fmt"
+	"os"
+
 	"github.com/pterm/pterm"
 )
 
@@ -12,6 +15,13 @@ var DebugEnabled bool
 // Set to false via the global --no-progress flag or CI env var.
 var ProgressEnabled = true
 
+// NoEmojiEnabled reports whether ASCII tags and the high-contrast palette are in use.
+// Set via the global --no-emoji flag or the EFCTL_NO_EMOJI env var.
+var NoEmojiEnabled bool
+
+// spinnerChars is the spinner frame set; SetNoEmoji swaps it for plain ASCII.
+var spinnerChars = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
 var (
 	// Emojis
 	SuccessEmoji = "✅"
@@ -23,6 +33,12 @@ var (
 	CleanEmoji   = "🧹"
 	PlayEmoji    = "▶️ "
 	GlobeEmoji   = "🌍"
+	ObjectEmoji  = "📦"
+	LinkEmoji    = "🔗"
+	GraphQLEmoji = "📊"
+	AppEmoji     = "💻"
+	KeyEmoji     = "🔑"
+	PersonEmoji  = "👤"
 
 	// Printers
 	Info    = SpacedPrinter{pterm.PrefixPrinter{Prefix: pterm.Prefix{Text: "  INF  ", Style: pterm.NewStyle(pterm.FgBlack, pterm.BgCyan)}, MessageStyle: pterm.NewStyle(pterm.FgDefault)}}
@@ -34,48 +50,50 @@ var (
 	Debug = DebugPrinter{SpacedPrinter{pterm.PrefixPrinter{Prefix: pterm.Prefix{Text: " DEBUG ", Style: pterm.NewStyle(pterm.FgBlack, pterm.BgMagenta)}, MessageStyle: pterm.NewStyle(pterm.FgGray)}}}
 )
 
+// SpacedPrinter adds a blank line after each message and redacts private keys
+// so they never reach logs that users paste into bug reports.
 type SpacedPrinter struct {
 	pterm.PrefixPrinter
 }
 
 func (s SpacedPrinter) Print(a ...any) *pterm.TextPrinter {
-	p := s.PrefixPrinter.Print(a...)
+	p := s.PrefixPrinter.Print(redactArgs(a))
 	pterm.Println()
 	return p
 }
 
 func (s SpacedPrinter) Println(a ...any) *pterm.TextPrinter {
-	p := s.PrefixPrinter.Println(a...)
+	p := s.PrefixPrinter.Println(redactLineArgs(a))
 	pterm.Println()
 	return p
 }
 
 func (s SpacedPrinter) Printf(format string, a ...any) *pterm.TextPrinter {
-	p := s.PrefixPrinter.Printf(format, a...)
+	p := s.PrefixPrinter.Print(Redact(fmt.Sprintf(format, a...)))
 	pterm.Println()
 	return p
 }
 
 func (s SpacedPrinter) Printfln(format string, a ...any) *pterm.TextPrinter {
-	p := s.PrefixPrinter.Printfln(format, a...)
+	p := s.PrefixPrinter.Println(Redact(fmt.Sprintf(format, a...)))
 	pterm.Println()
 	return p
 }
 
 func (s SpacedPrinter) Sprint(a ...any) string {
-	return s.PrefixPrinter.Sprint(a...) + "\n"
+	return s.PrefixPrinter.Sprint(redactArgs(a)) + "\n"
 }
 
 func (s SpacedPrinter) Sprintln(a ...any) string {
-	return s.PrefixPrinter.Sprintln(a...) + "\n"
+	return s.PrefixPrinter.Sprintln(redactLineArgs(a)) + "\n"
 }
 
 func (s SpacedPrinter) Sprintf(format string, a ...any) string {
-	return s.PrefixPrinter.Sprintf(format, a...) + "\n"
+	return s.PrefixPrinter.Sprint(Redact(fmt.Sprintf(format, a...))) + "\n"
 }
 
 func (s SpacedPrinter) Sprintfln(format string, a ...any) string {
-	return s.PrefixPrinter.Sprintfln(format, a...) + "\n"
+	return s.PrefixPrinter.Sprintln(Redact(fmt.Sprintf(format, a...))) + "\n"
 }
 
 // DebugPrinter wraps SpacedPrinter and only emits output when DebugEnabled is true.
@@ -112,7 +130,47 @@ func (d DebugPrinter) Printfln(format string, a ...any) {
 }
 
 func init() {
-	pterm.EnableColor()
+	_ = SetColorMode(ColorAuto)
+	if v := os.Getenv("EFCTL_NO_EMOJI"); v != "" && v != "0" && v != "false" {
+		SetNoEmoji()
+	}
+}
+
+// SetNoEmoji replaces emoji with ASCII tags and switches the printers to a
+// high-contrast palette that does not rely on red/green to convey meaning.
+func SetNoEmoji() {
+	NoEmojiEnabled = true
+
+	SuccessEmoji = "[OK]"
+	ErrorEmoji = "[ERR]"
+	InfoEmoji = "[INFO]"
+	DockerEmoji = "[DOCKER]"
+	PodmanEmoji = "[PODMAN]"
+	GitEmoji = "[GIT]"
+	CleanEmoji = "[CLEAN]"
+	PlayEmoji = "[>]"
+	GlobeEmoji = "[WWW]"
+	ObjectEmoji = "[OBJ]"
+	LinkEmoji = "[LINK]"
+	GraphQLEmoji = "[GQL]"
+	AppEmoji = "[APP]"
+	KeyEmoji = "[KEY]"
+	PersonEmoji = "[CHAR]"
+
+	spinnerChars = []string{"|", "/", "-", "\\"}
+
+	prefix := pterm.NewStyle(pterm.FgLightWhite, pterm.BgBlack, pterm.Bold)
+	message := pterm.NewStyle(pterm.FgDefault)
+	Info.Prefix.Style = prefix
+	Success.Prefix.Style = prefix
+	Warn.Prefix.Style = prefix
+	Error.Prefix.Style = prefix
+	Debug.Prefix.Style = prefix
+	Info.MessageStyle = message
+	Success.MessageStyle = message
+	Warn.MessageStyle = message
+	Error.MessageStyle = message
+	Debug.MessageStyle = message
 }
 
 // Ensure our custom spacing applies to spinners manually without relying on pterm's implicit newlines.
@@ -122,6 +180,7 @@ type SpacedSpinner struct {
 
 // Success displays the success printer with trailing newline spacing
 func (s SpacedSpinner) Success(message ...any) {
+	spinnerEvent("success", message)
 	wasActive := s.IsActive && !pterm.RawOutput
 	s.SpinnerPrinter.Success(message...)
 	if !wasActive {
@@ -131,6 +190,7 @@ func (s SpacedSpinner) Success(message ...any) {
 
 // Fail displays the fail printer with trailing newline spacing
 func (s SpacedSpinner) Fail(message ...any) {
+	spinnerEvent("fail", message)
 	wasActive := s.IsActive && !pterm.RawOutput
 	s.SpinnerPrinter.Fail(message...)
 	if !wasActive {
@@ -140,6 +200,7 @@ func (s SpacedSpinner) Fail(message ...any) {
 
 // Warning displays the warning printer with trailing newline spacing
 func (s SpacedSpinner) Warning(message ...any) {
+	spinnerEvent("warning", message)
 	wasActive := s.IsActive && !pterm.RawOutput
 	s.SpinnerPrinter.Warning(message...)
 	if !wasActive {
@@ -149,6 +210,7 @@ func (s SpacedSpinner) Warning(message ...any) {
 
 // Info displays the info printer with trailing newline spacing
 func (s SpacedSpinner) Info(message ...any) {
+	spinnerEvent("info", message)
 	wasActive := s.IsActive && !pterm.RawOutput
 	s.SpinnerPrinter.Info(message...)
 	if !wasActive {
@@ -156,9 +218,15 @@ func (s SpacedSpinner) Info(message ...any) {
 	}
 }
 
+// UpdateText changes the spinner text.
+func (s SpacedSpinner) UpdateText(text string) {
+	spinnerEvent("update", []any{text})
+	s.SpinnerPrinter.UpdateText(text)
+}
+
 // Spin configures and returns a spaced spinner
 func Spin(text string) (*SpacedSpinner, error) {
-	chars := []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+	chars := spinnerChars
 	seqLen := 40
 	var gradientSeq []string
 
@@ -183,6 +251,7 @@ func Spin(text string) (*SpacedSpinner, error) {
 	pterm.DefaultSpinner.WarningPrinter = &Warn
 	pterm.DefaultSpinner.InfoPrinter = &Info
 
+	spinnerEvent("start", []any{text})
 	s := pterm.DefaultSpinner.WithText(text)
 	if !ProgressEnabled {
 		return &SpacedSpinner{s}, nil
@@ -93,3 +93,41 @@ func TestSpacedSpinner_Success_Active(t *testing.T) {
 		t.Errorf("Expected output to contain 'Done' message, got %q", output)
 	}
 }
+
+func TestSetNoEmoji(t *testing.T) {
+	oldGlobe, oldSuccess, oldObject, oldChars := GlobeEmoji, SuccessEmoji, ObjectEmoji, spinnerChars
+	oldLink, oldGraphQL, oldApp, oldKey, oldPerson := LinkEmoji, GraphQLEmoji, AppEmoji, KeyEmoji, PersonEmoji
+	oldInfo, oldSuccessPrinter, oldWarn, oldError, oldDebug := Info, Success, Warn, Error, Debug
+	defer func() {
+		NoEmojiEnabled = false
+		GlobeEmoji, SuccessEmoji, ObjectEmoji, spinnerChars = oldGlobe, oldSuccess, oldObject, oldChars
+		LinkEmoji, GraphQLEmoji, AppEmoji, KeyEmoji, PersonEmoji = oldLink, oldGraphQL, oldApp, oldKey, oldPerson
+		Info, Success, Warn, Error, Debug = oldInfo, oldSuccessPrinter, oldWarn, oldError, oldDebug
+	}()
+
+	SetNoEmoji()
+
+	if !NoEmojiEnabled {
+		t.Error("Expected NoEmojiEnabled to be set")
+	}
+	if GlobeEmoji != "[WWW]" || SuccessEmoji != "[OK]" || ObjectEmoji != "[OBJ]" {
+		t.Errorf("Expected ASCII tags, got %q %q %q", GlobeEmoji, SuccessEmoji, ObjectEmoji)
+	}
+	for _, e := range []string{LinkEmoji, GraphQLEmoji, AppEmoji, KeyEmoji, PersonEmoji} {
+		for _, r := range e {
+			if r > 127 {
+				t.Errorf("Expected an ASCII tag, got %q", e)
+			}
+		}
+	}
+	for _, c := range spinnerChars {
+		for _, r := range c {
+			if r > 127 {
+				t.Errorf("Expected ASCII spinner frames, got %q", c)
+			}
+		}
+	}
+	if Success.Prefix.Style != Error.Prefix.Style {
+		t.Error("Expected a single high-contrast prefix style for all printers")
+	}
+}
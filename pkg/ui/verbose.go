@@ -0,0 +1,39 @@
+package ui
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// VerboseEnabled makes Command echo every external command efctl runs.
+// Debug output implies verbose output.
+var VerboseEnabled bool
+
+// commandOutput is where Command writes; stderr keeps stdout clean for
+// machine-readable output.
+var commandOutput io.Writer = os.Stderr
+
+// Command echoes an external command line, with private keys redacted, when
+// verbose or debug output is enabled.
+func Command(name string, args ...string) {
+	if !VerboseEnabled && !DebugEnabled {
+		return
+	}
+	_, _ = fmt.Fprintln(commandOutput, "$ "+Redact(FormatCommand(name, args...)))
+}
+
+// FormatCommand renders a command line, quoting arguments that contain
+// whitespace or shell metacharacters so it can be copied into a shell.
+func FormatCommand(name string, args ...string) string {
+	parts := make([]string, 0, len(args)+1)
+	for _, a := range append([]string{name}, args...) {
+		if a == "" || strings.ContainsAny(a, " \t\n'\"\\$`|&;<>(){}*?!#~") {
+			a = strconv.Quote(a)
+		}
+		parts = append(parts, a)
+	}
+	return strings.Join(parts, " ")
+}
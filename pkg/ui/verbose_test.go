@@ -0,0 +1,36 @@
+package ui
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestFormatCommand(t *testing.T) {
+	got := FormatCommand("docker", "exec", "sui-playground", "/bin/bash", "-c", "cd /workspace && sui move build")
+	want := `docker exec sui-playground /bin/bash -c "cd /workspace && sui move build"`
+	if got != want {
+		t.Errorf("FormatCommand() = %q, want %q", got, want)
+	}
+	if got := FormatCommand("git", ""); got != `git ""` {
+		t.Errorf("FormatCommand() with empty arg = %q", got)
+	}
+}
+
+func TestCommand(t *testing.T) {
+	var buf bytes.Buffer
+	oldOutput, oldVerbose, oldDebug := commandOutput, VerboseEnabled, DebugEnabled
+	commandOutput = &buf
+	defer func() { commandOutput, VerboseEnabled, DebugEnabled = oldOutput, oldVerbose, oldDebug }()
+
+	VerboseEnabled, DebugEnabled = false, false
+	Command("git", "clone", "https://example.com/repo.git")
+	if buf.Len() != 0 {
+		t.Fatalf("expected no output when verbose is off, got %q", buf.String())
+	}
+
+	VerboseEnabled = true
+	Command("sui", "keytool", "import", "suiprivkey1qabc", "ed25519")
+	if got, want := buf.String(), "$ sui keytool import suiprivkey[REDACTED] ed25519\n"; got != want {
+		t.Errorf("Command() = %q, want %q", got, want)
+	}
+}
@@ -5,14 +5,25 @@ package validate
 
 import (
 	"fmt"
+	"net/url"
+	"path"
 	"path/filepath"
 	"regexp"
+	"runtime"
+	"sort"
 	"strings"
 )
 
 // suiAddressRe matches a Sui hex address: 0x followed by 1–64 hex characters.
 var suiAddressRe = regexp.MustCompile(`^0x[a-fA-F0-9]{1,64}$`)
 
+// suiPrivateKeyRe matches a bech32-encoded Sui private key.
+var suiPrivateKeyRe = regexp.MustCompile(`^suiprivkey1[02-9ac-hj-np-z]+$`)
+
+// envKeyRe matches a .env variable name: a letter or underscore followed by
+// letters, digits, or underscores.
+var envKeyRe = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
 // safePathSegmentRe matches path segments that are safe for use in shell commands
 // and container paths (alphanumeric, hyphens, underscores, dots).
 var safePathSegmentRe = regexp.MustCompile(`^[a-zA-Z0-9._-]+$`)
@@ -23,6 +34,13 @@ var allowedNetworks = map[string]bool{
 	"testnet":  true,
 }
 
+// allowedBuildEnvs is the set of Move build environments accepted by --build-env.
+var allowedBuildEnvs = map[string]bool{
+	"testnet": true,
+	"mainnet": true,
+	"devnet":  true,
+}
+
 // SuiAddress validates that s is a well-formed Sui hex address (0x-prefixed, 1–64 hex chars).
 func SuiAddress(s string) error {
 	if !suiAddressRe.MatchString(s) {
@@ -31,6 +49,32 @@ func SuiAddress(s string) error {
 	return nil
 }
 
+// SuiPrivateKey validates that s looks like a bech32 Sui private key
+// (suiprivkey1...). It checks the format only, not the checksum.
+func SuiPrivateKey(s string) error {
+	if !suiPrivateKeyRe.MatchString(s) {
+		return fmt.Errorf("invalid Sui private key: must be a bech32 suiprivkey1... string")
+	}
+	return nil
+}
+
+// URL validates that s is an absolute http or https URL with a host.
+func URL(s string) error {
+	u, err := url.Parse(s)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid URL %q: must be an absolute http or https URL", s)
+	}
+	return nil
+}
+
+// EnvKey validates that s is a safe .env variable name.
+func EnvKey(s string) error {
+	if !envKeyRe.MatchString(s) {
+		return fmt.Errorf("invalid env key %q: must start with a letter or underscore and contain only letters, digits, and underscores", s)
+	}
+	return nil
+}
+
 // Network validates that s is a supported network name.
 func Network(s string) error {
 	if !allowedNetworks[s] {
@@ -43,6 +87,19 @@ func Network(s string) error {
 	return nil
 }
 
+// BuildEnv validates that s is a known Move build environment.
+func BuildEnv(s string) error {
+	if !allowedBuildEnvs[s] {
+		allowed := make([]string, 0, len(allowedBuildEnvs))
+		for k := range allowedBuildEnvs {
+			allowed = append(allowed, k)
+		}
+		sort.Strings(allowed)
+		return fmt.Errorf("invalid build environment %q: must be one of %s", s, strings.Join(allowed, ", "))
+	}
+	return nil
+}
+
 // ContractPath validates that a relative contract path does not escape the
 // expected parent directory via traversal (../).
 func ContractPath(s string) error {
@@ -72,6 +129,26 @@ func ContractPath(s string) error {
 	return nil
 }
 
+// ContainerWorkdir validates a directory relative to /workspace inside the
+// container. Container paths are always slash-separated, regardless of host OS.
+func ContainerWorkdir(s string) error {
+	if s == "" {
+		return fmt.Errorf("container working directory must not be empty")
+	}
+	if strings.HasPrefix(s, "/") {
+		return fmt.Errorf("container working directory must be relative to /workspace, got absolute: %s", s)
+	}
+	for _, part := range strings.Split(path.Clean(s), "/") {
+		if part == ".." {
+			return fmt.Errorf("container working directory must not contain directory traversal (..): %s", s)
+		}
+		if part != "." && !safePathSegmentRe.MatchString(part) {
+			return fmt.Errorf("container working directory segment %q contains disallowed characters", part)
+		}
+	}
+	return nil
+}
+
 // WorkspacePath validates a workspace directory path. It allows absolute and
 // relative paths but rejects obviously dangerous patterns.
 func WorkspacePath(s string) error {
@@ -92,12 +169,33 @@ func WorkspacePath(s string) error {
 		return fmt.Errorf("cannot resolve workspace path: %w", err)
 	}
 
-	systemDirs := []string{"/", "/etc", "/usr", "/bin", "/sbin", "/var", "/boot", "/dev", "/proc", "/sys"}
-	for _, d := range systemDirs {
-		if abs == d {
-			return fmt.Errorf("workspace path must not be a system directory: %s", abs)
-		}
+	if isSystemDir(abs, runtime.GOOS) {
+		return fmt.Errorf("workspace path must not be a system directory: %s", abs)
 	}
 
 	return nil
 }
+
+var unixSystemDirs = []string{"/", "/etc", "/usr", "/bin", "/sbin", "/var", "/boot", "/dev", "/proc", "/sys"}
+
+var windowsSystemDirs = []string{`C:\`, `C:\Windows`, `C:\Windows\System32`, `C:\Windows\SysWOW64`, `C:\Program Files`, `C:\Program Files (x86)`}
+
+// isSystemDir reports whether abs is a protected system directory on goos.
+// Windows paths are compared case-insensitively, ignoring a trailing separator.
+func isSystemDir(abs, goos string) bool {
+	if goos == "windows" {
+		trimmed := strings.TrimRight(abs, `\/`)
+		for _, d := range windowsSystemDirs {
+			if strings.EqualFold(trimmed, strings.TrimRight(d, `\`)) {
+				return true
+			}
+		}
+		return false
+	}
+	for _, d := range unixSystemDirs {
+		if abs == d {
+			return true
+		}
+	}
+	return false
+}
@@ -36,6 +36,43 @@ func TestSuiAddress_Invalid(t *testing.T) {
 	}
 }
 
+func TestSuiPrivateKey(t *testing.T) {
+	if err := SuiPrivateKey("suiprivkey1qzdlfxn2qa2lj5uprl8pyhexs02sg2wrhdy7qaq50cqgnffw4c2477kg9h3"); err != nil {
+		t.Errorf("expected valid key, got: %v", err)
+	}
+	for _, k := range []string{"", "suiprivkey", "suiprivkey1ABC", "suiprivkey1qzb!", "0xabc"} {
+		if err := SuiPrivateKey(k); err == nil {
+			t.Errorf("expected %q to be invalid, got nil", k)
+		}
+	}
+}
+
+func TestURL(t *testing.T) {
+	for _, u := range []string{"http://localhost:9000", "https://fullnode.testnet.sui.io:443"} {
+		if err := URL(u); err != nil {
+			t.Errorf("expected %q to be valid, got: %v", u, err)
+		}
+	}
+	for _, u := range []string{"", "localhost:9000", "ftp://host", "http://", "http//host"} {
+		if err := URL(u); err == nil {
+			t.Errorf("expected %q to be invalid, got nil", u)
+		}
+	}
+}
+
+func TestEnvKey(t *testing.T) {
+	for _, k := range []string{"SPONSOR_ADDRESSES", "_PRIVATE", "a1"} {
+		if err := EnvKey(k); err != nil {
+			t.Errorf("expected %q to be valid, got: %v", k, err)
+		}
+	}
+	for _, k := range []string{"", "1KEY", "KEY=VALUE", "KEY NAME", "KEY;rm"} {
+		if err := EnvKey(k); err == nil {
+			t.Errorf("expected %q to be invalid, got nil", k)
+		}
+	}
+}
+
 func TestNetwork_Valid(t *testing.T) {
 	for _, n := range []string{"localnet", "testnet"} {
 		if err := Network(n); err != nil {
@@ -52,6 +89,19 @@ func TestNetwork_Invalid(t *testing.T) {
 	}
 }
 
+func TestBuildEnv(t *testing.T) {
+	for _, env := range []string{"testnet", "mainnet", "devnet"} {
+		if err := BuildEnv(env); err != nil {
+			t.Errorf("expected %q to be valid, got: %v", env, err)
+		}
+	}
+	for _, env := range []string{"", "localnet", "Testnet", "testnet --json"} {
+		if err := BuildEnv(env); err == nil {
+			t.Errorf("expected %q to be invalid, got nil", env)
+		}
+	}
+}
+
 func TestContractPath_Valid(t *testing.T) {
 	valid := []string{
 		"smart_gate",
@@ -83,6 +133,38 @@ func TestContractPath_Invalid(t *testing.T) {
 	}
 }
 
+func TestContainerWorkdir_Valid(t *testing.T) {
+	valid := []string{
+		"builder-scaffold",
+		"world-contracts",
+		"builder-scaffold/dapps",
+		"./world-contracts",
+		".",
+	}
+	for _, p := range valid {
+		if err := ContainerWorkdir(p); err != nil {
+			t.Errorf("expected %q to be valid, got: %v", p, err)
+		}
+	}
+}
+
+func TestContainerWorkdir_Invalid(t *testing.T) {
+	invalid := []string{
+		"",
+		"/etc",
+		"../etc",
+		"world-contracts/../../etc",
+		"foo bar",
+		"foo;ls",
+		`foo\bar`,
+	}
+	for _, p := range invalid {
+		if err := ContainerWorkdir(p); err == nil {
+			t.Errorf("expected %q to be invalid, got nil", p)
+		}
+	}
+}
+
 func TestWorkspacePath_Valid(t *testing.T) {
 	valid := []string{
 		".",
@@ -97,6 +179,33 @@ func TestWorkspacePath_Valid(t *testing.T) {
 	}
 }
 
+func TestIsSystemDir_Windows(t *testing.T) {
+	blocked := []string{
+		`C:\`,
+		`C:\Windows`,
+		`c:\windows\system32`,
+		`C:\WINDOWS\System32\`,
+		`C:\Program Files`,
+		`c:\program files (x86)`,
+	}
+	for _, p := range blocked {
+		if !isSystemDir(p, "windows") {
+			t.Errorf("expected %q to be a Windows system directory", p)
+		}
+	}
+
+	allowed := []string{`C:\Users\dev\efctl`, `D:\Windows`, `C:\Windows\System32\..\..\work`}
+	for _, p := range allowed {
+		if isSystemDir(p, "windows") {
+			t.Errorf("expected %q to be allowed on Windows", p)
+		}
+	}
+
+	if isSystemDir(`C:\Windows`, "linux") {
+		t.Error("Windows directories should only be checked on Windows")
+	}
+}
+
 func TestWorkspacePath_Invalid(t *testing.T) {
 	invalid := []string{
 		"",